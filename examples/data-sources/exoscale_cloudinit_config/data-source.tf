@@ -0,0 +1,31 @@
+data "exoscale_cloudinit_config" "my_user_data" {
+  part = [
+    {
+      content = yamlencode({
+        packages = ["nginx"]
+      })
+    },
+    {
+      content = file("${path.module}/cloud-config.yaml")
+    },
+    {
+      content_type = "text/x-shellscript"
+      filename     = "bootstrap.sh"
+      content      = file("${path.module}/bootstrap.sh")
+    },
+  ]
+}
+
+data "exoscale_template" "my_template" {
+  zone = "ch-gva-2"
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_compute_instance" "my_instance" {
+  zone        = "ch-gva-2"
+  name        = "my-instance"
+  template_id = data.exoscale_template.my_template.id
+  type        = "standard.medium"
+  disk_size   = 10
+  user_data   = data.exoscale_cloudinit_config.my_user_data.rendered
+}
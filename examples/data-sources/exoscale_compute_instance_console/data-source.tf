@@ -0,0 +1,14 @@
+data "exoscale_compute_instance" "my_instance" {
+  zone = "ch-gva-2"
+  name = "my-instance"
+}
+
+data "exoscale_compute_instance_console" "my_instance" {
+  zone = "ch-gva-2"
+  id   = data.exoscale_compute_instance.my_instance.id
+}
+
+output "my_instance_console_url" {
+  value     = data.exoscale_compute_instance_console.my_instance.url
+  sensitive = true
+}
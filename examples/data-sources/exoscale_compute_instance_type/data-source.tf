@@ -0,0 +1,8 @@
+data "exoscale_compute_instance_type" "my_instance_type" {
+  zone = "ch-gva-2"
+  name = "standard.medium"
+}
+
+output "my_instance_type_memory" {
+  value = data.exoscale_compute_instance_type.my_instance_type.memory
+}
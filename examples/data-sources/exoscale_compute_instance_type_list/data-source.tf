@@ -0,0 +1,22 @@
+data "exoscale_compute_instance_type_list" "my_instance_type_list" {
+  zone = "ch-gva-2"
+
+  family     = "standard"
+  authorized = true
+}
+
+locals {
+  # Types are sorted by increasing memory: pick the smallest one with at least 8 GiB.
+  my_instance_type = [
+    for t in data.exoscale_compute_instance_type_list.my_instance_type_list.types : t.name
+    if t.memory >= 8 * 1024 * 1024 * 1024
+  ][0]
+}
+
+resource "exoscale_compute_instance" "my_instance" {
+  zone        = "ch-gva-2"
+  name        = "my-instance"
+  template_id = "<template ID>"
+  type        = local.my_instance_type
+  disk_size   = 10
+}
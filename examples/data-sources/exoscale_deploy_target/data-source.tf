@@ -0,0 +1,18 @@
+data "exoscale_deploy_target" "my_deploy_target" {
+  zone = "ch-gva-2"
+  name = "my-dedicated-hypervisor"
+}
+
+data "exoscale_template" "my_template" {
+  zone = "ch-gva-2"
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_compute_instance" "my_instance" {
+  zone             = "ch-gva-2"
+  name             = "my-instance"
+  template_id      = data.exoscale_template.my_template.id
+  type             = "standard.medium"
+  disk_size        = 10
+  deploy_target_id = data.exoscale_deploy_target.my_deploy_target.id
+}
@@ -0,0 +1,13 @@
+data "exoscale_deploy_target_list" "my_deploy_target_list" {
+  zone = "ch-gva-2"
+
+  type = "dedicated"
+}
+
+output "my_deploy_target_ids" {
+  value = join("\n", formatlist(
+    "%s (%s)",
+    data.exoscale_deploy_target_list.my_deploy_target_list.targets.*.name,
+    data.exoscale_deploy_target_list.my_deploy_target_list.targets.*.id,
+  ))
+}
@@ -0,0 +1,14 @@
+data "exoscale_sks_cluster" "my_sks_cluster" {
+  zone = "ch-gva-2"
+  name = "my-sks-cluster"
+}
+
+data "exoscale_sks_cluster_authority_cert" "my_sks_cluster_kubelet" {
+  zone       = "ch-gva-2"
+  cluster_id = data.exoscale_sks_cluster.my_sks_cluster.id
+  authority  = "kubelet"
+}
+
+output "my_sks_cluster_kubelet_ca_expires_at" {
+  value = data.exoscale_sks_cluster_authority_cert.my_sks_cluster_kubelet.expires_at
+}
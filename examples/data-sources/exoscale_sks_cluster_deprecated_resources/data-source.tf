@@ -0,0 +1,17 @@
+data "exoscale_sks_cluster" "my_sks_cluster" {
+  zone = "ch-gva-2"
+  name = "my-sks-cluster"
+}
+
+data "exoscale_sks_cluster_deprecated_resources" "my_sks_cluster" {
+  zone           = "ch-gva-2"
+  cluster_id     = data.exoscale_sks_cluster.my_sks_cluster.id
+  target_version = "1.30.2"
+}
+
+check "sks_upgrade" {
+  assert {
+    condition     = length(data.exoscale_sks_cluster_deprecated_resources.my_sks_cluster.resources) == 0
+    error_message = "Some resources are no longer served by Kubernetes 1.30."
+  }
+}
@@ -0,0 +1,13 @@
+data "exoscale_sks_cluster" "my_sks_cluster" {
+  zone = "ch-gva-2"
+  name = "my-sks-cluster"
+}
+
+data "exoscale_sks_cluster_inspection" "my_sks_cluster" {
+  zone       = "ch-gva-2"
+  cluster_id = data.exoscale_sks_cluster.my_sks_cluster.id
+}
+
+output "my_sks_cluster_inspection" {
+  value = data.exoscale_sks_cluster_inspection.my_sks_cluster.report
+}
@@ -0,0 +1,10 @@
+data "exoscale_sks_versions" "v1_30" {
+  zone  = "ch-gva-2"
+  minor = "1.30"
+}
+
+resource "exoscale_sks_cluster" "my_sks_cluster" {
+  zone    = "ch-gva-2"
+  name    = "my-sks-cluster"
+  version = data.exoscale_sks_versions.v1_30.latest_version
+}
@@ -0,0 +1,14 @@
+data "exoscale_compute_instance" "my_instance" {
+  zone = "ch-gva-2"
+  name = "my-instance"
+}
+
+resource "exoscale_elastic_ip" "my_elastic_ip" {
+  zone = "ch-gva-2"
+}
+
+resource "exoscale_elastic_ip_attachment" "my_instance_ip" {
+  zone          = "ch-gva-2"
+  elastic_ip_id = exoscale_elastic_ip.my_elastic_ip.id
+  instance_id   = data.exoscale_compute_instance.my_instance.id
+}
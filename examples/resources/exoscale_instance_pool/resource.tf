@@ -11,4 +11,10 @@ resource "exoscale_instance_pool" "my_instance_pool" {
   instance_type = "standard.medium"
   disk_size     = 10
   size          = 3
+
+  # Replace the existing members one at a time when the template or user data change.
+  rolling_update {
+    max_unavailable       = 1
+    pause_between_batches = "1m"
+  }
 }
@@ -0,0 +1,5 @@
+# An existing private network attachment may be imported by `<private network ID>/<instance ID>@<zone>`:
+
+terraform import \
+  exoscale_private_network_attachment.my_instance \
+  04fb76a2-6d22-49be-8a7b-2f6e0b1c5b19/f81d4fae-7dec-11d0-a765-00a0c91e6bf6@ch-gva-2
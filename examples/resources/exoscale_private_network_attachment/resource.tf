@@ -0,0 +1,20 @@
+data "exoscale_compute_instance" "my_instance" {
+  zone = "ch-gva-2"
+  name = "my-instance"
+}
+
+resource "exoscale_private_network" "my_private_network" {
+  zone = "ch-gva-2"
+  name = "my-private-network"
+
+  netmask  = "255.255.255.0"
+  start_ip = "10.0.0.20"
+  end_ip   = "10.0.0.253"
+}
+
+resource "exoscale_private_network_attachment" "my_instance" {
+  zone               = "ch-gva-2"
+  private_network_id = exoscale_private_network.my_private_network.id
+  instance_id        = data.exoscale_compute_instance.my_instance.id
+  ip_address         = "10.0.0.20"
+}
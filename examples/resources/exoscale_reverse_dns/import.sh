@@ -0,0 +1,5 @@
+# An existing reverse DNS record may be imported by `<instance or Elastic IP ID>@<zone>`:
+
+terraform import \
+  exoscale_reverse_dns.my_elastic_ip \
+  f81d4fae-7dec-11d0-a765-00a0c91e6bf6@ch-gva-2
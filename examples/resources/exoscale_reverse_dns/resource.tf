@@ -0,0 +1,13 @@
+resource "exoscale_elastic_ip" "my_elastic_ip" {
+  zone = "ch-gva-2"
+
+  lifecycle {
+    ignore_changes = [reverse_dns]
+  }
+}
+
+resource "exoscale_reverse_dns" "my_elastic_ip" {
+  zone          = "ch-gva-2"
+  elastic_ip_id = exoscale_elastic_ip.my_elastic_ip.id
+  domain_name   = "www.example.net"
+}
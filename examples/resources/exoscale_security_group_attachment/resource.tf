@@ -0,0 +1,14 @@
+data "exoscale_compute_instance" "my_instance" {
+  zone = "ch-gva-2"
+  name = "my-instance"
+}
+
+data "exoscale_security_group" "monitoring" {
+  name = "monitoring"
+}
+
+resource "exoscale_security_group_attachment" "my_instance_monitoring" {
+  zone              = "ch-gva-2"
+  security_group_id = data.exoscale_security_group.monitoring.id
+  instance_id       = data.exoscale_compute_instance.my_instance.id
+}
@@ -2,6 +2,7 @@ package exoscale
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,10 +14,212 @@ import (
 )
 
 const (
+	defaultSKSClusterCNI          = "calico"
+	defaultSKSClusterServiceLevel = "pro"
+
 	dsSKSClusterIdentifier = "exoscale_sks_cluster"
 	dsSKSClusterID         = "id"
+
+	resSKSClusterAttrAddons             = "addons"
+	resSKSClusterAttrAggregationLayerCA = "aggregation_ca"
+	resSKSClusterAttrAutoUpgrade        = "auto_upgrade"
+	resSKSClusterAttrCNI                = "cni"
+	resSKSClusterAttrControlPlaneCA     = "control_plane_ca"
+	resSKSClusterAttrCreatedAt          = "created_at"
+	resSKSClusterAttrDescription        = "description"
+	resSKSClusterAttrEndpoint           = "endpoint"
+	resSKSClusterAttrExoscaleCCM        = "exoscale_ccm"
+	resSKSClusterAttrExoscaleCSI        = "exoscale_csi"
+	resSKSClusterAttrKubeletCA          = "kubelet_ca"
+	resSKSClusterAttrLabels             = "labels"
+	resSKSClusterAttrMetricsServer      = "metrics_server"
+	resSKSClusterAttrID                 = "id"
+	resSKSClusterAttrName               = "name"
+	resSKSClusterAttrNodepools          = "nodepools"
+	resSKSClusterAttrOIDCClientID       = "client_id"
+	resSKSClusterAttrOIDCGroupsClaim    = "groups_claim"
+	resSKSClusterAttrOIDCGroupsPrefix   = "groups_prefix"
+	resSKSClusterAttrOIDCIssuerURL      = "issuer_url"
+	resSKSClusterAttrOIDCRequiredClaim  = "required_claim"
+	resSKSClusterAttrOIDCUsernameClaim  = "username_claim"
+	resSKSClusterAttrOIDCUsernamePrefix = "username_prefix"
+	resSKSClusterAttrServiceLevel       = "service_level"
+	resSKSClusterAttrState              = "state"
+	resSKSClusterAttrVersion            = "version"
+	resSKSClusterAttrZone               = "zone"
 )
 
+func resourceSKSClusterIDString(d general.ResourceIDStringer) string {
+	return general.ResourceIDString(d, "exoscale_sks_cluster")
+}
+
+// sksClusterAttributes returns the attributes of an SKS cluster exposed by the data source.
+func sksClusterAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		resSKSClusterAttrAddons: {
+			Type:     schema.TypeSet,
+			Set:      schema.HashString,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			Computed: true,
+			Deprecated: "This attribute has been replaced by `exoscale_ccm`/`metrics_server` " +
+				"attributes, it will be removed in a future release.",
+		},
+
+		resSKSClusterAttrAggregationLayerCA: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CA certificate (in PEM format) for TLS communications between the control plane and the aggregation layer (e.g. `metrics-server`).",
+		},
+		resSKSClusterAttrAutoUpgrade: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Enable automatic upgrading of the control plane version.",
+		},
+		resSKSClusterAttrCNI: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultSKSClusterCNI,
+			Description: fmt.Sprintf(`The CNI plugin that is to be used. Available options are "calico" or "cilium". Defaults to %q. Setting empty string will result in a cluster with no CNI.`, defaultSKSClusterCNI),
+		},
+		resSKSClusterAttrControlPlaneCA: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CA certificate (in PEM format) for TLS communications between control plane components.",
+		},
+		resSKSClusterAttrCreatedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The cluster creation date.",
+		},
+		resSKSClusterAttrDescription: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A free-form text describing the cluster.",
+		},
+		resSKSClusterAttrEndpoint: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The cluster API endpoint.",
+		},
+		resSKSClusterAttrExoscaleCCM: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Deploy the Exoscale [Cloud Controller Manager](https://github.com/exoscale/exoscale-cloud-controller-manager/) in the control plane (boolean; default: `true`).",
+		},
+		resSKSClusterAttrKubeletCA: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CA certificate (in PEM format) for TLS communications between kubelets and the control plane.",
+		},
+		resSKSClusterAttrMetricsServer: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Deploy the [Kubernetes Metrics Server](https://github.com/kubernetes-sigs/metrics-server/) in the control plane (boolean; default: `true`).",
+		},
+		resSKSClusterAttrExoscaleCSI: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Deploy the Exoscale [Container Storage Interface](https://github.com/exoscale/exoscale-csi-driver/) on worker nodes (boolean; default: `false`; requires the CCM to be enabled).",
+		},
+		resSKSClusterAttrLabels: {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "A map of key/value labels.",
+		},
+		resSKSClusterAttrName: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The SKS cluster name.",
+		},
+		resSKSClusterAttrID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The SKS cluster ID.",
+		},
+		resSKSClusterAttrNodepools: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The list of [exoscale_sks_nodepool](./sks_nodepool.md) (IDs) attached to the cluster.",
+		},
+		"oidc": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "An OpenID Connect configuration to provide to the Kubernetes API server. Structure is documented below.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					resSKSClusterAttrOIDCClientID: {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The OpenID client ID.",
+					},
+					resSKSClusterAttrOIDCGroupsClaim: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An OpenID JWT claim to use as the user's group.",
+					},
+					resSKSClusterAttrOIDCGroupsPrefix: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An OpenID prefix prepended to group claims.",
+					},
+					resSKSClusterAttrOIDCIssuerURL: {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The OpenID provider URL.",
+					},
+					resSKSClusterAttrOIDCRequiredClaim: {
+						Type:        schema.TypeMap,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Description: "A map of key/value pairs that describes a required claim in the OpenID Token.",
+					},
+					resSKSClusterAttrOIDCUsernameClaim: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An OpenID JWT claim to use as the user name.",
+					},
+					resSKSClusterAttrOIDCUsernamePrefix: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "An OpenID prefix prepended to username claims.",
+					},
+				},
+			},
+		},
+		resSKSClusterAttrServiceLevel: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultSKSClusterServiceLevel,
+			Description: "The service level of the control plane (`pro` or `starter`; default: `pro`). A `starter` cluster can be upgraded to `pro` in place, but a `pro` cluster can't be downgraded to `starter`.",
+		},
+		resSKSClusterAttrState: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The cluster state.",
+		},
+		resSKSClusterAttrVersion: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The version of the control plane (default: latest version available from the API; see the [exoscale_sks_versions](../data-sources/sks_versions.md) data source for reference; may only be set at creation time).",
+		},
+		resSKSClusterAttrZone: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+		},
+	}
+}
+
 func dataSourceSKSCluster() *schema.Resource {
 	ret := &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -39,7 +242,7 @@ func dataSourceSKSCluster() *schema.Resource {
 		ReadContext: dataSourceSKSClusterRead,
 	}
 
-	general.AddAttributes(ret, resourceSKSCluster().Schema)
+	general.AddAttributes(ret, sksClusterAttributes())
 
 	return ret
 }
@@ -15,10 +15,193 @@ import (
 )
 
 const (
+	defaultSKSNodepoolDiskSize       int64 = 50
+	defaultSKSNodepoolInstancePrefix       = "pool"
+
+	resSKSNodepoolAttrAntiAffinityGroupIDs   = "anti_affinity_group_ids"
+	resSKSNodepoolAttrClusterID              = "cluster_id"
+	resSKSNodepoolAttrCreatedAt              = "created_at"
+	resSKSNodepoolAttrDeployTargetID         = "deploy_target_id"
+	resSKSNodepoolAttrDescription            = "description"
+	resSKSNodepoolAttrDiskSize               = "disk_size"
+	resSKSNodepoolAttrInstancePoolID         = "instance_pool_id"
+	resSKSNodepoolAttrInstancePrefix         = "instance_prefix"
+	resSKSNodepoolAttrInstanceType           = "instance_type"
+	resSKSNodepoolAttrKubeletGC              = "kubelet_image_gc"
+	resSKSNodepoolAttrKubeletGCMinAge        = "min_age"
+	resSKSNodepoolAttrKubeletGCHighThreshold = "high_threshold"
+	resSKSNodepoolAttrKubeletGCLowThreshold  = "low_threshold"
+	resSKSNodepoolAttrLabels                 = "labels"
+	resSKSNodepoolAttrID                     = "id"
+	resSKSNodepoolAttrName                   = "name"
+	resSKSNodepoolAttrPrivateNetworkIDs      = "private_network_ids"
+	resSKSNodepoolAttrSecurityGroupIDs       = "security_group_ids"
+	resSKSNodepoolAttrSize                   = "size"
+	resSKSNodepoolAttrState                  = "state"
+	resSKSNodepoolAttrStorageLVM             = "storage_lvm"
+	resSKSNodepoolAttrTaints                 = "taints"
+	resSKSNodepoolAttrTemplateID             = "template_id"
+	resSKSNodepoolAttrVersion                = "version"
+	resSKSNodepoolAttrZone                   = "zone"
+
 	dsSKSNodepoolIdentifier = "exoscale_sks_nodepool"
 	dsSKSNodepoolID         = "id"
 )
 
+func resourceSKSNodepoolIDString(d general.ResourceIDStringer) string {
+	return general.ResourceIDString(d, "exoscale_sks_nodepool")
+}
+
+// sksNodepoolAttributes returns the attributes of an SKS node pool exposed by the data source.
+func sksNodepoolAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		resSKSNodepoolAttrAntiAffinityGroupIDs: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs) to be attached to the managed instances.",
+		},
+		resSKSNodepoolAttrClusterID: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The parent [exoscale_sks_cluster](./sks_cluster.md) ID.",
+		},
+		resSKSNodepoolAttrCreatedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The pool creation date.",
+		},
+		resSKSNodepoolAttrDeployTargetID: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A deploy target ID (see the [exoscale_deploy_target](../data-sources/deploy_target.md) data source).",
+		},
+		resSKSNodepoolAttrDescription: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A free-form text describing the pool.",
+		},
+		resSKSNodepoolAttrDiskSize: {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     defaultSKSNodepoolDiskSize,
+			Description: "The managed instances disk size (GiB; default: `50`).",
+		},
+		resSKSNodepoolAttrInstancePoolID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The underlying [exoscale_instance_pool](./instance_pool.md) ID.",
+		},
+		resSKSNodepoolAttrInstancePrefix: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultSKSNodepoolInstancePrefix,
+			Description: "The string used to prefix the managed instances name (default `pool`).",
+		},
+		resSKSNodepoolAttrInstanceType: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateComputeInstanceType,
+			// Ignore case differences
+			DiffSuppressFunc: suppressCaseDiff,
+			Description:      "The managed compute instances type (`<family>.<size>`, e.g. `standard.medium`; use the [exoscale_compute_instance_type_list](../data-sources/compute_instance_type_list.md) data source for the list of available types).",
+		},
+		resSKSNodepoolAttrKubeletGC: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Configuration for this nodepool's kubelet image garbage collector",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					resSKSNodepoolAttrKubeletGCMinAge: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The minimum age for an unused image before it is garbage collected (k8s duration format, eg. 1h)",
+					},
+					resSKSNodepoolAttrKubeletGCHighThreshold: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The percent of disk usage after which image garbage collection is always run",
+					},
+					resSKSNodepoolAttrKubeletGCLowThreshold: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The percent of disk usage before which image garbage collection is never run",
+					},
+				},
+			},
+		},
+		resSKSNodepoolAttrLabels: {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "A map of key/value labels.",
+		},
+		resSKSNodepoolAttrID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The SKS node pool ID.",
+		},
+		resSKSNodepoolAttrName: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The SKS node pool name.",
+		},
+		resSKSNodepoolAttrPrivateNetworkIDs: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A list of [exoscale_private_network](./private_network.md) (IDs) to be attached to the managed instances.",
+		},
+		resSKSNodepoolAttrSecurityGroupIDs: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A list of [exoscale_security_group](./security_group.md) (IDs) to be attached to the managed instances.",
+		},
+		resSKSNodepoolAttrSize: {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		resSKSNodepoolAttrState: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current pool state.",
+		},
+		resSKSNodepoolAttrStorageLVM: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Create nodes with non-standard partitioning for persistent storage (requires min 100G of disk space) (may only be set at creation time).",
+		},
+		resSKSNodepoolAttrTaints: {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: `A map of key/value Kubernetes [taints](https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/) ('taints = { <key> = "<value>:<effect>" }').`,
+		},
+		resSKSNodepoolAttrTemplateID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The managed instances template ID.",
+		},
+		resSKSNodepoolAttrVersion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The managed instances version.",
+		},
+		resSKSNodepoolAttrZone: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+		},
+	}
+}
+
 func dataSourceSKSNodepool() *schema.Resource {
 	ret := &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -45,7 +228,7 @@ func dataSourceSKSNodepool() *schema.Resource {
 		ReadContext: dataSourceSKSNodepoolRead,
 	}
 
-	general.AddAttributes(ret, resourceSKSNodepool().Schema)
+	general.AddAttributes(ret, sksNodepoolAttributes())
 
 	return ret
 }
@@ -119,7 +302,7 @@ func dataSourceSKSNodepoolRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if nMatches < 0 {
+	if nMatches == 0 {
 		nodepoolID, _ := d.GetOk(dsSKSNodepoolID)
 		nodepoolName, _ := d.GetOk(resSKSNodepoolAttrName)
 		return diag.Errorf("no nodepool matches cluster %q with name %q or id %q", clusterID, nodepoolName, nodepoolID)
@@ -15,8 +15,10 @@ import (
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/anti_affinity_group"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/deploy_target"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_pool"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_type"
 
 	exov2 "github.com/exoscale/egoscale/v2"
 	exov3 "github.com/exoscale/egoscale/v3"
@@ -87,6 +89,10 @@ func Provider() *schema.Provider {
 			"exoscale_anti_affinity_group":   anti_affinity_group.DataSource(),
 			"exoscale_compute_instance":      instance.DataSource(),
 			"exoscale_compute_instance_list": instance.DataSourceList(),
+			instance_type.Name:               instance_type.DataSource(),
+			instance_type.NameList:           instance_type.DataSourceList(),
+			deploy_target.Name:               deploy_target.DataSource(),
+			deploy_target.NameList:           deploy_target.DataSourceList(),
 			"exoscale_domain":                dataSourceDomain(),
 			"exoscale_domain_record":         dataSourceDomainRecord(),
 			"exoscale_elastic_ip":            dataSourceElasticIP(),
@@ -115,9 +121,6 @@ func Provider() *schema.Provider {
 			"exoscale_private_network":     resourcePrivateNetwork(),
 			"exoscale_security_group":      resourceSecurityGroup(),
 			"exoscale_security_group_rule": resourceSecurityGroupRule(),
-			"exoscale_sks_cluster":         resourceSKSCluster(),
-			"exoscale_sks_kubeconfig":      resourceSKSKubeconfig(),
-			"exoscale_sks_nodepool":        resourceSKSNodepool(),
 			"exoscale_ssh_key":             resourceSSHKey(),
 		},
 
@@ -19,9 +19,16 @@ import (
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
 	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/block_storage"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/cloudinit_config"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/database"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/elastic_ip_attachment"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/iam"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_console"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/nlb_service"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/private_network_attachment"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/reverse_dns"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/security_group_attachment"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sks"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sos_bucket_policy"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/zones"
 )
@@ -214,6 +221,12 @@ func (p *ExoscaleProvider) DataSources(ctx context.Context) []func() datasource.
 			return &nlb_service.NLBServiceListDataSource{}
 		},
 		sos_bucket_policy.NewDataSourceSOSBucketPolicy,
+		instance_console.NewDataSource,
+		cloudinit_config.NewDataSource,
+		sks.NewDataSourceAuthorityCert,
+		sks.NewDataSourceDeprecatedResources,
+		sks.NewDataSourceInspection,
+		sks.NewDataSourceVersions,
 	}
 }
 
@@ -230,6 +243,13 @@ func (p *ExoscaleProvider) Resources(ctx context.Context) []func() resource.Reso
 		block_storage.NewResourceVolume,
 		block_storage.NewResourceSnapshot,
 		sos_bucket_policy.NewResourceSOSBucketPolicy,
+		reverse_dns.NewResourceReverseDNS,
+		private_network_attachment.NewResourcePrivateNetworkAttachment,
+		security_group_attachment.NewResourceSecurityGroupAttachment,
+		elastic_ip_attachment.NewResourceElasticIPAttachment,
+		sks.NewResourceCluster,
+		sks.NewResourceKubeconfig,
+		sks.NewResourceNodepool,
 	}
 }
 
@@ -0,0 +1,32 @@
+package cloudinit_config
+
+const (
+	Name = "exoscale_cloudinit_config"
+
+	AttrCompressed                  = "compressed"
+	attrCompressedDescription       = "Whether the rendered document was gzip-compressed."
+	AttrCompression                 = "compression"
+	attrCompressionDescription      = "The compression mode (`auto`, `gzip` or `none`; default: `auto`). In `auto` mode the document is only gzip-compressed if it would otherwise exceed the maximum user data size."
+	AttrID                          = "id"
+	attrIDDescription               = "The SHA256 checksum of the rendered document."
+	AttrMergeCloudConfig            = "merge_cloud_config"
+	attrMergeCloudConfigDescription = "Whether to merge all `text/cloud-config` parts into a single part (default: `true`). Maps are merged recursively, lists are concatenated and later scalar values override earlier ones."
+	AttrPart                        = "part"
+	attrPartDescription             = "The list of parts making the multi-part MIME document, in order."
+	AttrPartContent                 = "content"
+	attrPartContentDescription      = "The part content."
+	AttrPartContentType             = "content_type"
+	attrPartContentTypeDescription  = "The part MIME content type (default: `text/cloud-config`)."
+	AttrPartFilename                = "filename"
+	attrPartFilenameDescription     = "A filename to report in the part `Content-Disposition` header."
+	AttrRendered                    = "rendered"
+	attrRenderedDescription         = "The base64-encoded (and possibly gzip-compressed) document, to be used as `user_data`."
+	AttrSize                        = "size"
+	attrSizeDescription             = "The size of the rendered document (bytes)."
+
+	CompressionAuto = "auto"
+	CompressionGzip = "gzip"
+	CompressionNone = "none"
+
+	DefaultContentType = "text/cloud-config"
+)
@@ -0,0 +1,197 @@
+package cloudinit_config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+var DataSourceDescription = fmt.Sprintf(`Render a multi-part MIME [cloud-init](https://cloudinit.readthedocs.io/) document from a list of parts,
+to be used as the `+"`user_data`"+` of Compute instances and instance pools.
+
+Cloud-config parts are merged into a single one, and the document is gzip-compressed when it would otherwise exceed
+the maximum user data size (%d bytes once base64-encoded). Documents which still don't fit are rejected at plan time.
+
+Corresponding resources: [exoscale_compute_instance](../resources/compute_instance.md), [exoscale_instance_pool](../resources/instance_pool.md).`,
+	config.ComputeMaxUserDataLength,
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DataSource{}
+
+// DataSource defines the data source implementation.
+type DataSource struct{}
+
+// NewDataSource creates instance of DataSource.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSourceModel defines the data source data model.
+type DataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Compressed       types.Bool   `tfsdk:"compressed"`
+	Compression      types.String `tfsdk:"compression"`
+	MergeCloudConfig types.Bool   `tfsdk:"merge_cloud_config"`
+	Parts            []PartModel  `tfsdk:"part"`
+	Rendered         types.String `tfsdk:"rendered"`
+	Size             types.Int64  `tfsdk:"size"`
+}
+
+// PartModel defines a document part data model.
+type PartModel struct {
+	Content     types.String `tfsdk:"content"`
+	ContentType types.String `tfsdk:"content_type"`
+	Filename    types.String `tfsdk:"filename"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloudinit_config"
+}
+
+// Schema defines data source attributes.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrIDDescription,
+				Computed:            true,
+			},
+			AttrCompressed: schema.BoolAttribute{
+				MarkdownDescription: attrCompressedDescription,
+				Computed:            true,
+			},
+			AttrCompression: schema.StringAttribute{
+				MarkdownDescription: attrCompressionDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(CompressionAuto, CompressionGzip, CompressionNone),
+				},
+			},
+			AttrMergeCloudConfig: schema.BoolAttribute{
+				MarkdownDescription: attrMergeCloudConfigDescription,
+				Optional:            true,
+			},
+			AttrPart: schema.ListNestedAttribute{
+				MarkdownDescription: attrPartDescription,
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						AttrPartContent: schema.StringAttribute{
+							MarkdownDescription: attrPartContentDescription,
+							Required:            true,
+						},
+						AttrPartContentType: schema.StringAttribute{
+							MarkdownDescription: attrPartContentTypeDescription,
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									contentTypeRegexp,
+									`must be a "text/*" MIME type`,
+								),
+							},
+						},
+						AttrPartFilename: schema.StringAttribute{
+							MarkdownDescription: attrPartFilenameDescription,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			AttrRendered: schema.StringAttribute{
+				MarkdownDescription: attrRenderedDescription,
+				Computed:            true,
+			},
+			AttrSize: schema.Int64Attribute{
+				MarkdownDescription: attrSizeDescription,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Parts) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrPart),
+			"missing document parts",
+			"at least one part is required",
+		)
+		return
+	}
+
+	parts := make([]part, len(data.Parts))
+	for i, p := range data.Parts {
+		parts[i] = part{
+			content:     p.Content.ValueString(),
+			contentType: DefaultContentType,
+			filename:    p.Filename.ValueString(),
+		}
+		if v := p.ContentType.ValueString(); v != "" {
+			parts[i].contentType = v
+		}
+	}
+
+	mergeCloudConfig := true
+	if !data.MergeCloudConfig.IsNull() {
+		mergeCloudConfig = data.MergeCloudConfig.ValueBool()
+	}
+
+	compression := CompressionAuto
+	if v := data.Compression.ValueString(); v != "" {
+		compression = v
+	}
+
+	rendered, compressed, err := render(parts, mergeCloudConfig, compression)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to render cloud-init document",
+			err.Error(),
+		)
+		return
+	}
+
+	checksum := sha256.Sum256([]byte(rendered))
+
+	data.ID = types.StringValue(hex.EncodeToString(checksum[:]))
+	data.Compressed = types.BoolValue(compressed)
+	data.Rendered = types.StringValue(rendered)
+	data.Size = types.Int64Value(int64(len(rendered)))
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
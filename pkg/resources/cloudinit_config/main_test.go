@@ -0,0 +1,57 @@
+package cloudinit_config_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestCloudinitConfig(t *testing.T) {
+	dataSourceName := "data.exoscale_cloudinit_config.test"
+	dataSourceLargeName := "data.exoscale_cloudinit_config.test_large"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.cloudinit_config.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compressed", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rendered"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "size"),
+					resource.TestCheckResourceAttr(dataSourceLargeName, "compressed", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceLargeName, "rendered"),
+				),
+			},
+			{
+				Config:      testutils.ParseTestdataConfig("./testdata/002.cloudinit_config_too_large.tf.tmpl", &testdataSpec),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`maximum allowed length is 32768 bytes`),
+			},
+			{
+				// The rendered user data (compressed or not) must not diff against the decoded instance state.
+				Config: testutils.ParseTestdataConfig("./testdata/003.cloudinit_config_instance.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("exoscale_compute_instance.test", "user_data"),
+					resource.TestCheckResourceAttrSet("exoscale_compute_instance.test_large", "user_data"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,191 @@
+package cloudinit_config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+// mimeBoundary is fixed so that rendering the same parts always yields the same document.
+const mimeBoundary = "MIMEBOUNDARY"
+
+const cloudConfigHeader = "#cloud-config"
+
+var contentTypeRegexp = regexp.MustCompile(`^text/[a-z0-9.+-]+$`)
+
+type part struct {
+	contentType string
+	content     string
+	filename    string
+}
+
+// render assembles parts into a multi-part MIME document, and returns it base64-encoded
+// (and gzip-compressed depending on the compression mode) along with whether it was compressed.
+func render(parts []part, mergeCloudConfig bool, compression string) (string, bool, error) {
+	if mergeCloudConfig {
+		var err error
+		if parts, err = mergeCloudConfigParts(parts); err != nil {
+			return "", false, err
+		}
+	}
+
+	document, err := buildMultipart(parts)
+	if err != nil {
+		return "", false, err
+	}
+
+	compressed := compression == CompressionGzip
+	if compression == CompressionAuto {
+		compressed = base64.StdEncoding.EncodedLen(len(document)) >= config.ComputeMaxUserDataLength
+	}
+
+	if compressed {
+		if document, err = compress(document); err != nil {
+			return "", false, err
+		}
+	}
+
+	rendered := base64.StdEncoding.EncodeToString(document)
+	if len(rendered) >= config.ComputeMaxUserDataLength {
+		return "", false, fmt.Errorf(
+			"rendered user data is %d bytes long (compressed: %t), maximum allowed length is %d bytes",
+			len(rendered),
+			compressed,
+			config.ComputeMaxUserDataLength,
+		)
+	}
+
+	return rendered, compressed, nil
+}
+
+// mergeCloudConfigParts replaces all cloud-config parts with a single one at the position of the first.
+func mergeCloudConfigParts(parts []part) ([]part, error) {
+	var (
+		merged   map[string]interface{}
+		position = -1
+		out      = make([]part, 0, len(parts))
+	)
+
+	for i, p := range parts {
+		if p.contentType != DefaultContentType {
+			out = append(out, p)
+			continue
+		}
+
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal([]byte(p.content), &doc); err != nil {
+			return nil, fmt.Errorf("unable to parse part %d as cloud-config: %w", i, err)
+		}
+
+		if position < 0 {
+			position = len(out)
+			out = append(out, p)
+		}
+		merged = mergeMaps(merged, doc)
+	}
+
+	if position < 0 {
+		return out, nil
+	}
+
+	content, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize merged cloud-config: %w", err)
+	}
+	out[position].content = cloudConfigHeader + "\n" + string(content)
+
+	return out, nil
+}
+
+// mergeMaps merges src into dst recursively: maps are merged, lists concatenated,
+// and other values from src override those of dst.
+func mergeMaps(dst, src map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{}, len(src))
+	}
+
+	for k, v := range src {
+		switch sv := v.(type) {
+		case map[string]interface{}:
+			if dv, ok := dst[k].(map[string]interface{}); ok {
+				dst[k] = mergeMaps(dv, sv)
+				continue
+			}
+		case []interface{}:
+			if dv, ok := dst[k].([]interface{}); ok {
+				dst[k] = append(dv, sv...)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+
+	return dst
+}
+
+func buildMultipart(parts []part) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("Content-Type: multipart/mixed; boundary=\"" + mimeBoundary + "\"\n")
+	buf.WriteString("MIME-Version: 1.0\n\n")
+
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(mimeBoundary); err != nil {
+		return nil, err
+	}
+
+	for i, p := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", p.contentType)
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("MIME-Version", "1.0")
+		if p.filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.filename))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write part %d: %w", i, err)
+		}
+
+		content := p.content
+		if !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		if _, err := pw.Write([]byte(content)); err != nil {
+			return nil, fmt.Errorf("unable to write part %d: %w", i, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gz, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := gz.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
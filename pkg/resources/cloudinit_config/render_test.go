@@ -0,0 +1,85 @@
+package cloudinit_config
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func TestRenderMergesCloudConfig(t *testing.T) {
+	parts := []part{
+		{contentType: DefaultContentType, content: "#cloud-config\npackages: [nginx]\nwrite_files:\n  - path: /a\n"},
+		{contentType: "text/x-shellscript", content: "#!/bin/sh\necho hello", filename: "hello.sh"},
+		{contentType: DefaultContentType, content: "packages: [curl]\ntimezone: UTC\n"},
+	}
+
+	rendered, compressed, err := render(parts, true, CompressionAuto)
+	require.NoError(t, err)
+	require.False(t, compressed)
+
+	decoded, err := base64.StdEncoding.DecodeString(rendered)
+	require.NoError(t, err)
+	document := string(decoded)
+
+	require.Equal(t, 2, strings.Count(document, "Content-Type: text/"))
+	require.Less(t, strings.Index(document, "text/cloud-config"), strings.Index(document, "text/x-shellscript"))
+	require.Contains(t, document, "packages:\n    - nginx\n    - curl\n")
+	require.Contains(t, document, "timezone: UTC\n")
+	require.Contains(t, document, `filename="hello.sh"`)
+
+	again, _, err := render(parts, true, CompressionAuto)
+	require.NoError(t, err)
+	require.Equal(t, rendered, again)
+}
+
+func TestRenderCompression(t *testing.T) {
+	// Compressible content over the limit is compressed in auto mode.
+	large := []part{{contentType: "text/x-shellscript", content: strings.Repeat("echo hello world\n", 3000)}}
+
+	rendered, compressed, err := render(large, true, CompressionAuto)
+	require.NoError(t, err)
+	require.True(t, compressed)
+
+	decoded, err := utils.DecodeUserData(rendered)
+	require.NoError(t, err)
+	require.Contains(t, decoded, "echo hello world\n")
+
+	_, _, err = render(large, true, CompressionNone)
+	require.ErrorContains(t, err, "maximum allowed length is 32768 bytes")
+
+	// Small content is left uncompressed unless requested.
+	small := []part{{contentType: "text/x-shellscript", content: "#!/bin/sh\n"}}
+
+	_, compressed, err = render(small, true, CompressionAuto)
+	require.NoError(t, err)
+	require.False(t, compressed)
+
+	_, compressed, err = render(small, true, CompressionGzip)
+	require.NoError(t, err)
+	require.True(t, compressed)
+
+	// Incompressible content over the limit is rejected with its size.
+	var random strings.Builder
+	for i := 0; i < 1000; i++ {
+		sum := sha256.Sum256([]byte(fmt.Sprint(i)))
+		random.WriteString(hex.EncodeToString(sum[:]))
+	}
+
+	_, _, err = render([]part{{contentType: "text/x-shellscript", content: random.String()}}, true, CompressionAuto)
+	require.ErrorContains(t, err, "compressed: true")
+}
+
+func TestRenderInvalidCloudConfig(t *testing.T) {
+	_, _, err := render([]part{{contentType: DefaultContentType, content: "- not\n- a map\n"}}, true, CompressionAuto)
+	require.ErrorContains(t, err, "unable to parse part 0 as cloud-config")
+
+	_, _, err = render([]part{{contentType: DefaultContentType, content: "- not\n- a map\n"}}, false, CompressionAuto)
+	require.NoError(t, err)
+}
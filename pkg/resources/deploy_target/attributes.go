@@ -0,0 +1,13 @@
+package deploy_target
+
+const (
+	Name     = "exoscale_deploy_target"
+	NameList = "exoscale_deploy_target_list"
+
+	AttrDescription = "description"
+	AttrID          = "id"
+	AttrName        = "name"
+	AttrTargets     = "targets"
+	AttrType        = "type"
+	AttrZone        = "zone"
+)
@@ -0,0 +1,130 @@
+package deploy_target
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exo "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+// DataSourceSchema returns a schema for a single deploy target data source.
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		AttrDescription: {
+			Description: "The deploy target description.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrID: {
+			Description: "The deploy target ID to match (conflicts with `name`).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		AttrName: {
+			Description: "The deploy target name to match (conflicts with `id`).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		AttrType: {
+			Description: "The deploy target type (`edge` or `dedicated`).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrZone: {
+			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
+
+func DataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch Exoscale deploy target data.
+
+Use the ` + "`id`" + ` attribute as the ` + "`deploy_target_id`" + ` of an [exoscale_compute_instance](../resources/compute_instance.md),
+[exoscale_instance_pool](../resources/instance_pool.md) or [exoscale_sks_nodepool](../resources/sks_nodepool.md).`,
+		Schema: func() map[string]*schema.Schema {
+			schema := DataSourceSchema()
+
+			schema[AttrID].ConflictsWith = []string{AttrName}
+			schema[AttrName].ConflictsWith = []string{AttrID}
+			return schema
+		}(),
+		ReadContext: dsRead,
+	}
+}
+
+func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, byID := d.GetOk(AttrID)
+	name, byName := d.GetOk(AttrName)
+	if !byID && !byName {
+		return diag.Errorf(
+			"either %s or %s must be specified",
+			AttrName,
+			AttrID,
+		)
+	}
+
+	var deployTarget *exo.DeployTarget
+	if byID {
+		deployTarget, err = client.GetDeployTarget(ctx, zone, id.(string))
+	} else {
+		deployTarget, err = client.FindDeployTarget(ctx, zone, name.(string))
+	}
+	if err != nil {
+		return diag.Errorf("unable to retrieve deploy target: %s", err)
+	}
+
+	d.SetId(*deployTarget.ID)
+
+	data := dsBuildData(deployTarget, zone)
+
+	for key, value := range data {
+		err := d.Set(key, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	return nil
+}
+
+// dsBuildData builds terraform data object from egoscale API struct.
+func dsBuildData(deployTarget *exo.DeployTarget, zone string) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	data[AttrDescription] = utils.DefaultString(deployTarget.Description, "")
+	data[AttrID] = deployTarget.ID
+	data[AttrName] = deployTarget.Name
+	data[AttrType] = deployTarget.Type
+	data[AttrZone] = zone
+
+	return data
+}
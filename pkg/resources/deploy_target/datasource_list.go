@@ -0,0 +1,110 @@
+package deploy_target
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/filter"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func DataSourceList() *schema.Resource {
+	ret := &schema.Resource{
+		Description: "List Exoscale deploy targets.",
+		Schema: map[string]*schema.Schema{
+			AttrZone: {
+				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			AttrTargets: {
+				Description: "The list of [exoscale_deploy_target](./deploy_target.md).",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: DataSourceSchema(),
+				},
+			},
+		},
+
+		ReadContext: dsListRead,
+	}
+
+	filter.AddFilterAttributes(ret, DataSourceSchema())
+
+	return ret
+}
+
+func dsListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, NameList),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployTargets, err := client.ListDeployTargets(ctx, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := filter.CreateFilters(ctx, d, DataSourceSchema())
+	if err != nil {
+		return diag.Errorf("failed to create filter: %q", err)
+	}
+
+	data := make([]interface{}, 0, len(deployTargets))
+	ids := make([]string, 0, len(deployTargets))
+
+	for _, deployTarget := range deployTargets {
+		// we use ID to generate a resource ID, we cannot list deploy targets without ID.
+		if deployTarget.ID == nil {
+			continue
+		}
+
+		ids = append(ids, *deployTarget.ID)
+
+		deployTargetData := dsBuildData(deployTarget, zone)
+		if !filter.CheckForMatch(deployTargetData, filters) {
+			continue
+		}
+
+		data = append(data, deployTargetData)
+	}
+
+	err = d.Set(AttrTargets, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// by sorting deploy target IDs we can generate the same resource ID regardless of the order in which
+	// API returns deploy targets in the list.
+	sort.Strings(ids)
+
+	d.SetId(fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(ids, "")))))
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, NameList),
+	})
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package deploy_target_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "exoscale_deploy_target_list" "test" {
+  # we omit the zone to trigger an error as the zone attribute must be mandatory.
+}`,
+				ExpectError: regexp.MustCompile("Missing required argument"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_deploy_target_list" "test" {
+  zone = "%s"
+}`, testutils.TestZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.exoscale_deploy_target_list.test", "targets.#"),
+				),
+			},
+		},
+	})
+}
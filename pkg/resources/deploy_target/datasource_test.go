@@ -0,0 +1,69 @@
+package deploy_target_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/deploy_target"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_deploy_target" "test" {
+  zone = "%s"
+}`, testutils.TestZoneName),
+				ExpectError: regexp.MustCompile("either name or id must be specified"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_deploy_target" "test" {
+  zone = "%s"
+  name = "lolnope"
+}`, testutils.TestZoneName),
+				ExpectError: regexp.MustCompile("unable to retrieve deploy target"),
+			},
+			{
+				// Deploy targets cannot be created: look up one of the existing ones.
+				Config: fmt.Sprintf(`
+data "exoscale_deploy_target_list" "test" {
+  zone = "%s"
+}
+
+data "exoscale_deploy_target" "by_name" {
+  zone = data.exoscale_deploy_target_list.test.zone
+  name = data.exoscale_deploy_target_list.test.targets.0.name
+}
+
+data "exoscale_deploy_target" "by_id" {
+  zone = data.exoscale_deploy_target_list.test.zone
+  id   = data.exoscale_deploy_target_list.test.targets.0.id
+}`, testutils.TestZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					dsCheckTarget("data.exoscale_deploy_target.by_name"),
+					dsCheckTarget("data.exoscale_deploy_target.by_id"),
+				),
+			},
+		},
+	})
+}
+
+func dsCheckTarget(ds string) resource.TestCheckFunc {
+	list := "data.exoscale_deploy_target_list.test"
+
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(ds, deploy_target.AttrID, list, "targets.0."+deploy_target.AttrID),
+		resource.TestCheckResourceAttrPair(ds, deploy_target.AttrName, list, "targets.0."+deploy_target.AttrName),
+		resource.TestCheckResourceAttrPair(ds, deploy_target.AttrType, list, "targets.0."+deploy_target.AttrType),
+		resource.TestCheckResourceAttrPair(ds, deploy_target.AttrDescription, list, "targets.0."+deploy_target.AttrDescription),
+		resource.TestCheckResourceAttr(ds, deploy_target.AttrZone, testutils.TestZoneName),
+	)
+}
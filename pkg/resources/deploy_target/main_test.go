@@ -0,0 +1,8 @@
+package deploy_target_test
+
+import "testing"
+
+func TestDeployTarget(t *testing.T) {
+	t.Run("DataSource", testDataSource)
+	t.Run("DataSourceList", testListDataSource)
+}
@@ -0,0 +1,13 @@
+package elastic_ip_attachment
+
+const (
+	Name = "exoscale_elastic_ip_attachment"
+
+	AttrElasticIPID            = "elastic_ip_id"
+	attrElasticIPIDDescription = "The [exoscale_elastic_ip](./elastic_ip.md) (ID) to attach."
+	AttrID                     = "id"
+	AttrInstanceID             = "instance_id"
+	attrInstanceIDDescription  = "The [exoscale_compute_instance](./compute_instance.md) (ID) to attach the Elastic IP to. Changing it moves the Elastic IP in place, attaching it to the new instance before detaching it from the previous one."
+	AttrZone                   = "zone"
+	attrZoneDescription        = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
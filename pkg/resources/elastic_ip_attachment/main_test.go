@@ -0,0 +1,64 @@
+package elastic_ip_attachment_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestElasticIPAttachment(t *testing.T) {
+	resourceName := "exoscale_elastic_ip_attachment.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1 Create attachment (the instance must not plan to detach it)
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.elastic_ip_attachment_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "instance_id",
+						"exoscale_compute_instance.test_primary", "id",
+					),
+				),
+			},
+			// 2 Move the Elastic IP in place
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.elastic_ip_attachment_update.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "instance_id",
+						"exoscale_compute_instance.test_secondary", "id",
+					),
+				),
+			},
+			// Import
+			{
+				ResourceName: resourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", s.RootModule().Resources[resourceName].Primary.ID, testdataSpec.Zone), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
@@ -0,0 +1,490 @@
+package elastic_ip_attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceElasticIPAttachmentDescription = `Attach an Exoscale [Elastic IP](https://community.exoscale.com/documentation/compute/eip/) to a [Compute Instance](https://community.exoscale.com/documentation/compute/).
+
+This resource allows managing Elastic IP attachments independently from the instance definition, e.g. to move
+an Elastic IP between instances during a failover: changing ` + "`instance_id`" + ` attaches the Elastic IP to the
+new instance before detaching it from the previous one, so that it is never left unattached.
+
+The [exoscale_compute_instance](./compute_instance.md) resource ignores Elastic IPs not listed in its
+` + "`elastic_ip_ids`" + ` attribute, which must not reference the same Elastic IP.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceElasticIPAttachment{}
+var _ resource.ResourceWithImportState = &ResourceElasticIPAttachment{}
+
+// ResourceElasticIPAttachment defines the resource implementation.
+type ResourceElasticIPAttachment struct {
+	client *exoscale.Client
+}
+
+// NewResourceElasticIPAttachment creates instance of ResourceElasticIPAttachment.
+func NewResourceElasticIPAttachment() resource.Resource {
+	return &ResourceElasticIPAttachment{}
+}
+
+// ResourceElasticIPAttachmentModel defines the resource data model.
+type ResourceElasticIPAttachmentModel struct {
+	ID          types.String `tfsdk:"id"`
+	ElasticIPID types.String `tfsdk:"elastic_ip_id"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Zone        types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceElasticIPAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_elastic_ip_attachment"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceElasticIPAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceElasticIPAttachmentDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: "The attachment ID (`<elastic_ip_id>/<instance_id>`).",
+				Computed:            true,
+			},
+			AttrElasticIPID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrElasticIPIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrInstanceID: schema.StringAttribute{
+				MarkdownDescription: attrInstanceIDDescription,
+				Required:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceElasticIPAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceElasticIPAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceElasticIPAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	elasticIPID, instanceID, err := parseIDs(plan.ElasticIPID.ValueString(), plan.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := attach(ctx, client, elasticIPID, instanceID); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to attach Elastic IP to instance",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", elasticIPID, instanceID))
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceElasticIPAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceElasticIPAttachmentModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	elasticIPID, instanceID, err := parseIDs(state.ElasticIPID.ValueString(), state.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	attached, err := isAttached(ctx, client, elasticIPID, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get instance",
+			err.Error(),
+		)
+		return
+	}
+	if !attached {
+		// Instance doesn't exist anymore or Elastic IP has been detached, signaling the core to remove the attachment from the state.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceElasticIPAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceElasticIPAttachmentModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.InstanceID.Equal(state.InstanceID) {
+		elasticIPID, previousInstanceID, err := parseIDs(state.ElasticIPID.ValueString(), state.InstanceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse attachment IDs",
+				err.Error(),
+			)
+			return
+		}
+
+		_, instanceID, err := parseIDs(plan.ElasticIPID.ValueString(), plan.InstanceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse attachment IDs",
+				err.Error(),
+			)
+			return
+		}
+
+		// The Elastic IP is already attached to the new instance if a previous
+		// update failed to detach it from the previous one.
+		attached, err := isAttached(ctx, client, elasticIPID, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to get instance",
+				err.Error(),
+			)
+			return
+		}
+
+		// An Elastic IP can be attached to several instances at once: attaching it
+		// to the new instance first ensures it is never left unattached.
+		if !attached {
+			if err := attach(ctx, client, elasticIPID, instanceID); err != nil {
+				resp.Diagnostics.AddError(
+					"unable to attach Elastic IP to instance",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		// The prior state is kept until the detachment succeeds: it still tracks the
+		// previous instance, so that the next apply plans the move again.
+		if err := detach(ctx, client, elasticIPID, previousInstanceID); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to detach Elastic IP from previous instance",
+				err.Error(),
+			)
+			return
+		}
+
+		state.InstanceID = plan.InstanceID
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.ElasticIPID.ValueString(), state.InstanceID.ValueString()))
+	state.Timeouts = plan.Timeouts
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceElasticIPAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceElasticIPAttachmentModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	elasticIPID, instanceID, err := parseIDs(state.ElasticIPID.ValueString(), state.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := detach(ctx, client, elasticIPID, instanceID); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to detach Elastic IP from instance",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceElasticIPAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) == 2 {
+		idParts = append(strings.Split(idParts[0], "/"), idParts[1])
+	}
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: elastic_ip_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceElasticIPAttachmentModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", idParts[0], idParts[1]))
+	state.ElasticIPID = types.StringValue(idParts[0])
+	state.InstanceID = types.StringValue(idParts[1])
+	state.Zone = types.StringValue(idParts[2])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// isAttached reports whether the Elastic IP is attached to the instance,
+// or false if the instance doesn't exist.
+func isAttached(ctx context.Context, client *exoscale.Client, elasticIPID, instanceID exoscale.UUID) (bool, error) {
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, eip := range instance.ElasticIPS {
+		if eip.ID == elasticIPID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func attach(ctx context.Context, client *exoscale.Client, elasticIPID, instanceID exoscale.UUID) error {
+	op, err := client.AttachInstanceToElasticIP(ctx, elasticIPID, exoscale.AttachInstanceToElasticIPRequest{
+		Instance: &exoscale.InstanceTarget{ID: instanceID},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+
+	return err
+}
+
+func detach(ctx context.Context, client *exoscale.Client, elasticIPID, instanceID exoscale.UUID) error {
+	op, err := client.DetachInstanceFromElasticIP(ctx, elasticIPID, exoscale.DetachInstanceFromElasticIPRequest{
+		Instance: &exoscale.InstanceTarget{ID: instanceID},
+	})
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+
+	return err
+}
+
+func parseIDs(elasticIPID, instanceID string) (exoscale.UUID, exoscale.UUID, error) {
+	eipID, err := exoscale.ParseUUID(elasticIPID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid Elastic IP ID: %w", err)
+	}
+
+	iID, err := exoscale.ParseUUID(instanceID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	return eipID, iID, nil
+}
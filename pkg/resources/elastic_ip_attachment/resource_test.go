@@ -0,0 +1,168 @@
+package elastic_ip_attachment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+const (
+	testElasticIPID        = "7b8ba0c8-7b39-4d38-8b8b-7d0e5b9e3a01"
+	testPreviousInstanceID = "0f1c2d3e-4a5b-4c6d-8e9f-0a1b2c3d4e5f"
+	testInstanceID         = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+)
+
+// testAPI is a minimal Exoscale API serving the calls of the attachment update.
+type testAPI struct {
+	attached map[string]bool // instance ID → Elastic IP attached
+	attaches int
+	detaches int
+	detachOK bool
+}
+
+func (a *testAPI) handler(endpoint *string) http.Handler {
+	mux := http.NewServeMux()
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	success := exoscale.Operation{ID: exoscale.UUID(testElasticIPID), State: exoscale.OperationStateSuccess}
+
+	mux.HandleFunc("GET /zone", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, exoscale.ListZonesResponse{Zones: []exoscale.Zone{{
+			Name:        "ch-gva-2",
+			APIEndpoint: exoscale.Endpoint(*endpoint),
+		}}})
+	})
+	mux.HandleFunc("GET /instance/{id}", func(w http.ResponseWriter, r *http.Request) {
+		instance := exoscale.Instance{ID: exoscale.UUID(r.PathValue("id"))}
+		if a.attached[r.PathValue("id")] {
+			instance.ElasticIPS = []exoscale.ElasticIP{{ID: exoscale.UUID(testElasticIPID)}}
+		}
+		writeJSON(w, instance)
+	})
+	mux.HandleFunc("PUT /elastic-ip/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Instance exoscale.InstanceTarget `json:"instance"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		switch r.PathValue("id") {
+		case testElasticIPID + ":attach":
+			a.attaches++
+			a.attached[req.Instance.ID.String()] = true
+		case testElasticIPID + ":detach":
+			a.detaches++
+			if !a.detachOK {
+				http.Error(w, `{"message":"detach failed"}`, http.StatusInternalServerError)
+				return
+			}
+			delete(a.attached, req.Instance.ID.String())
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		writeJSON(w, success)
+	})
+
+	return mux
+}
+
+func testUpdate(t *testing.T, api *testAPI) (ResourceElasticIPAttachmentModel, *resource.UpdateResponse) {
+	ctx := context.Background()
+
+	var endpoint string
+	server := httptest.NewServer(api.handler(&endpoint))
+	t.Cleanup(server.Close)
+	endpoint = server.URL
+
+	client, err := exoscale.NewClient(
+		credentials.NewStaticCredentials("EXOtest", "test"),
+		exoscale.ClientOptWithEndpoint(exoscale.Endpoint(server.URL)),
+	)
+	require.NoError(t, err)
+
+	r := &ResourceElasticIPAttachment{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// Start from an all-null object, so that the timeouts are typed.
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	empty := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	var model ResourceElasticIPAttachmentModel
+	require.False(t, empty.Get(ctx, &model).HasError())
+
+	model.ElasticIPID = types.StringValue(testElasticIPID)
+	model.Zone = types.StringValue("ch-gva-2")
+
+	priorModel := model
+	priorModel.ID = types.StringValue(testElasticIPID + "/" + testPreviousInstanceID)
+	priorModel.InstanceID = types.StringValue(testPreviousInstanceID)
+	prior := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, prior.Set(ctx, &priorModel).HasError())
+
+	planModel := model
+	planModel.ID = types.StringUnknown()
+	planModel.InstanceID = types.StringValue(testInstanceID)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, plan.Set(ctx, &planModel).HasError())
+
+	// The framework initializes the new state with the prior state.
+	resp := &resource.UpdateResponse{State: prior}
+	r.Update(ctx, resource.UpdateRequest{State: prior, Plan: plan}, resp)
+
+	var state ResourceElasticIPAttachmentModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+
+	return state, resp
+}
+
+func TestUpdateDetachFailure(t *testing.T) {
+	api := &testAPI{attached: map[string]bool{testPreviousInstanceID: true}}
+
+	state, resp := testUpdate(t, api)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, 1, api.attaches)
+	assert.Equal(t, 1, api.detaches)
+	assert.True(t, api.attached[testPreviousInstanceID])
+	assert.True(t, api.attached[testInstanceID])
+
+	// The state still tracks the previous instance, so that the next plan retries the move.
+	assert.Equal(t, testPreviousInstanceID, state.InstanceID.ValueString())
+	assert.Equal(t, testElasticIPID+"/"+testPreviousInstanceID, state.ID.ValueString())
+
+	// Retrying doesn't attach the Elastic IP twice.
+	api.detachOK = true
+	state, resp = testUpdate(t, api)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, 1, api.attaches)
+	assert.Equal(t, 2, api.detaches)
+	assert.False(t, api.attached[testPreviousInstanceID])
+	assert.True(t, api.attached[testInstanceID])
+	assert.Equal(t, testInstanceID, state.InstanceID.ValueString())
+	assert.Equal(t, testElasticIPID+"/"+testInstanceID, state.ID.ValueString())
+}
@@ -4,31 +4,40 @@ const (
 	Name     = "exoscale_compute_instance"
 	NameList = "exoscale_compute_instance_list"
 
-	AttrAntiAffinityGroupIDs  = "anti_affinity_group_ids"
-	AttrBlockStorageVolumeIDs = "block_storage_volume_ids"
-	AttrCreatedAt             = "created_at"
-	AttrDeployTargetID        = "deploy_target_id"
-	AttrDestroyProtected      = "destroy_protected"
-	AttrDiskSize              = "disk_size"
-	AttrElasticIPIDs          = "elastic_ip_ids"
-	AttrID                    = "id"
-	AttrIPv6                  = "ipv6"
-	AttrIPv6Address           = "ipv6_address"
-	AttrMACAddress            = "mac_address"
-	AttrLabels                = "labels"
-	AttrManagerID             = "manager_id"
-	AttrManagerType           = "manager_type"
-	AttrName                  = "name"
-	AttrNetworkInterface      = "network_interface"
-	AttrPrivateNetworkIDs     = "private_network_ids"
-	AttrPublicIPAddress       = "public_ip_address"
-	AttrPrivate               = "private"
-	AttrReverseDNS            = "reverse_dns"
-	AttrSSHKey                = "ssh_key"
-	AttrSecurityGroupIDs      = "security_group_ids"
-	AttrState                 = "state"
-	AttrTemplateID            = "template_id"
-	AttrType                  = "type"
-	AttrUserData              = "user_data"
-	AttrZone                  = "zone"
+	AttrAllowStoppingForUpdate = "allow_stopping_for_update"
+	AttrAntiAffinityGroupIDs   = "anti_affinity_group_ids"
+	AttrBlockStorageVolumeIDs  = "block_storage_volume_ids"
+	AttrCreatedAt              = "created_at"
+	AttrDeployTargetID         = "deploy_target_id"
+	AttrDestroyProtected       = "destroy_protected"
+	AttrDiskSize               = "disk_size"
+	AttrElasticIPIDs           = "elastic_ip_ids"
+	AttrID                     = "id"
+	AttrIPv6                   = "ipv6"
+	AttrIPv6Address            = "ipv6_address"
+	AttrMACAddress             = "mac_address"
+	AttrLabels                 = "labels"
+	AttrManagerID              = "manager_id"
+	AttrManagerType            = "manager_type"
+	AttrName                   = "name"
+	AttrNetworkInterface       = "network_interface"
+	AttrPrivateNetworkIDs      = "private_network_ids"
+	AttrPublicIPAddress        = "public_ip_address"
+	AttrPrivate                = "private"
+	AttrRebootTriggers         = "reboot_triggers"
+	AttrResetTriggers          = "reset_triggers"
+	AttrReverseDNS             = "reverse_dns"
+	AttrSSHKey                 = "ssh_key"
+	AttrSecurityGroupIDs       = "security_group_ids"
+	AttrState                  = "state"
+	AttrTemplateChangeStrategy = "template_change_strategy"
+	AttrTemplateID             = "template_id"
+	AttrType                   = "type"
+	AttrUserData               = "user_data"
+	AttrZone                   = "zone"
+)
+
+const (
+	TemplateChangeStrategyReplace = "replace"
+	TemplateChangeStrategyReset   = "reset"
 )
@@ -8,4 +8,6 @@ func TestInstance(t *testing.T) {
 	t.Run("Resource", testResource)
 	t.Run("DestroyProtection/ExplicitValue", testExplicitDestroyProtection)
 	t.Run("DestroyProtection/DefaultValue", testDefaultDestroyProtection)
+	t.Run("ResetStrategy", testResetStrategy)
+	t.Run("UpdateStrategy", testUpdateStrategy)
 }
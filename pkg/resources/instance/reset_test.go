@@ -0,0 +1,126 @@
+package instance_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var resetInstanceResource = `
+data "exoscale_template" "my_template" {
+  zone = "{{.Zone}}"
+  name = "{{.TemplateName}}"
+}
+
+resource "exoscale_compute_instance" "my_instance" {
+  zone = "{{.Zone}}"
+  name = "{{.Name}}"
+
+  template_id              = data.exoscale_template.my_template.id
+  template_change_strategy = "reset"
+  type                     = "standard.micro"
+  disk_size                = {{.DiskSize}}
+
+  reset_triggers = {
+    revision = "{{.Revision}}"
+  }
+}
+`
+
+var resetTmpl = template.Must(template.New("compute_instance").Parse(resetInstanceResource))
+
+type resetTestData struct {
+	Zone         string
+	Name         string
+	TemplateName string
+	DiskSize     int64
+	Revision     string
+}
+
+func buildResetTestConfig(t *testing.T, testData resetTestData) string {
+	var tmplBuf bytes.Buffer
+
+	err := resetTmpl.Execute(&tmplBuf, testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tmplBuf.String()
+}
+
+// checkInstanceNotReplaced records the instance ID on first call and
+// fails if it differs on subsequent calls.
+func checkInstanceNotReplaced(id *string) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		current, err := testutils.AttrFromState(s, "exoscale_compute_instance.my_instance", "id")
+		if err != nil {
+			return err
+		}
+
+		if *id == "" {
+			*id = current
+			return nil
+		}
+
+		if current != *id {
+			return fmt.Errorf("instance was replaced: expected ID %q, got %q", *id, current)
+		}
+
+		return nil
+	}
+}
+
+func testResetStrategy(t *testing.T) {
+	var instanceID string
+
+	data := resetTestData{
+		Zone:         testutils.TestZoneName,
+		Name:         acctest.RandomWithPrefix(testutils.Prefix),
+		TemplateName: "Linux Ubuntu 22.04 LTS 64-bit",
+		DiskSize:     10,
+		Revision:     "1",
+	}
+
+	templateUpdated := data
+	templateUpdated.TemplateName = "Linux Ubuntu 24.04 LTS 64-bit"
+	templateUpdated.DiskSize = 20
+
+	triggersUpdated := templateUpdated
+	triggersUpdated.Revision = "2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: buildResetTestConfig(t, data),
+				Check:  checkInstanceNotReplaced(&instanceID),
+			},
+			{
+				// test that a template change reinstalls the instance in place,
+				// resizing its disk as part of the same operation
+				Config: buildResetTestConfig(t, templateUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					checkInstanceNotReplaced(&instanceID),
+					resource.TestCheckResourceAttr("exoscale_compute_instance.my_instance", "disk_size", "20"),
+					resource.TestCheckResourceAttrPair(
+						"exoscale_compute_instance.my_instance", "template_id",
+						"data.exoscale_template.my_template", "id",
+					),
+				),
+			},
+			{
+				// test that changing reset_triggers reinstalls the instance in place
+				Config: buildResetTestConfig(t, triggersUpdated),
+				Check:  checkInstanceNotReplaced(&instanceID),
+			},
+		},
+	})
+}
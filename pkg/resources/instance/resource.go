@@ -17,11 +17,17 @@ import (
 	v3 "github.com/exoscale/egoscale/v3"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_type"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
 func Resource() *schema.Resource {
 	s := map[string]*schema.Schema{
+		AttrAllowStoppingForUpdate: {
+			Description: "Whether the instance may be stopped/restarted to apply `type` or `disk_size` changes; if `false`, planning such a change fails instead (boolean; default: `true`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
 		AttrAntiAffinityGroupIDs: {
 			Description: "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs) to attach to the instance (may only be set at creation time).",
 			Type:        schema.TypeSet,
@@ -41,7 +47,7 @@ func Resource() *schema.Resource {
 			Optional:    true,
 		},
 		AttrDeployTargetID: {
-			Description: "A deploy target ID.",
+			Description: "A deploy target ID (see the [exoscale_deploy_target](../data-sources/deploy_target.md) data source).",
 			Type:        schema.TypeString,
 			Optional:    true,
 			ForceNew:    true,
@@ -54,7 +60,7 @@ func Resource() *schema.Resource {
 			ValidateFunc: validation.IntAtLeast(10),
 		},
 		AttrElasticIPIDs: {
-			Description: "A list of [exoscale_elastic_ip](./elastic_ip.md) (IDs) to attach to the instance.",
+			Description: "A list of [exoscale_elastic_ip](./elastic_ip.md) (IDs) to attach to the instance. Elastic IPs attached otherwise (e.g. with the [exoscale_elastic_ip_attachment](./elastic_ip_attachment.md) resource) are ignored.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Set:         schema.HashString,
@@ -96,7 +102,7 @@ func Resource() *schema.Resource {
 			Deprecated:  "Use the network_interface block instead.",
 		},
 		AttrNetworkInterface: {
-			Description: "Private network interfaces (may be specified multiple times). Structure is documented below.",
+			Description: "Private network interfaces (may be specified multiple times). Structure is documented below. Private network attachments not declared here (e.g. managed with the [exoscale_private_network_attachment](./private_network_attachment.md) resource) are ignored.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Elem: &schema.Resource{
@@ -139,6 +145,18 @@ func Resource() *schema.Resource {
 			Optional:    true,
 			Default:     false,
 		},
+		AttrRebootTriggers: {
+			Description: "A map of arbitrary key/value pairs; changing any of them reboots the instance (e.g. to apply a kernel change).",
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+		},
+		AttrResetTriggers: {
+			Description: "A map of arbitrary key/value pairs; changing any of them reinstalls the instance in place from its current template (e.g. to re-run updated `user_data`).",
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+		},
 		AttrReverseDNS: {
 			Description: "Domain name for reverse DNS record.",
 			Type:        schema.TypeString,
@@ -150,7 +168,7 @@ func Resource() *schema.Resource {
 			Optional:    true,
 		},
 		AttrSecurityGroupIDs: {
-			Description: "A list of [exoscale_security_group](./security_group.md) (IDs) to attach to the instance.",
+			Description: "A list of [exoscale_security_group](./security_group.md) (IDs) to attach to the instance. Security groups attached otherwise (e.g. with the [exoscale_security_group_attachment](./security_group_attachment.md) resource) are ignored.",
 			Type:        schema.TypeSet,
 			Optional:    true,
 			Set:         schema.HashString,
@@ -162,14 +180,22 @@ func Resource() *schema.Resource {
 			Optional:    true,
 			Computed:    true,
 		},
+		AttrTemplateChangeStrategy: {
+			Description: "The strategy to apply when `template_id` changes: `replace` destroys and re-creates the instance, `reset` reinstalls it in place, preserving its IP and MAC addresses, private network leases and attached volumes (default: `replace`). **WARNING**: resetting the instance wipes its disk.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				TemplateChangeStrategyReplace,
+				TemplateChangeStrategyReset,
+			}, false)),
+		},
 		AttrTemplateID: {
-			Description: "The [exoscale_template](../data-sources/template.md) (ID) to use when creating the instance.",
+			Description: "The [exoscale_template](../data-sources/template.md) (ID) to use when creating the instance. Changing it re-creates the instance, unless `template_change_strategy` is set to `reset`.",
 			Type:        schema.TypeString,
 			Required:    true,
-			ForceNew:    true,
 		},
 		AttrType: {
-			Description:      "The instance type (`<family>.<size>`, e.g. `standard.medium`; use the [Exoscale CLI](https://github.com/exoscale/cli/) - `exo compute instance-type list` - for the list of available types). **WARNING**: updating this attribute stops/restarts the instance.",
+			Description:      "The instance type (`<family>.<size>`, e.g. `standard.medium`; use the [exoscale_compute_instance_type_list](../data-sources/compute_instance_type_list.md) data source for the list of available types, validated at plan time). **WARNING**: updating this attribute stops/restarts the instance.",
 			Type:             schema.TypeString,
 			Required:         true,
 			ValidateDiagFunc: utils.ValidateComputeInstanceType,
@@ -177,9 +203,10 @@ func Resource() *schema.Resource {
 			DiffSuppressFunc: utils.SuppressCaseDiff,
 		},
 		AttrUserData: {
-			Description:      "[cloud-init](https://cloudinit.readthedocs.io/) configuration.",
+			Description:      "[cloud-init](https://cloudinit.readthedocs.io/) configuration. Use the [exoscale_cloudinit_config](../data-sources/cloudinit_config.md) data source to assemble multi-part documents.",
 			Type:             schema.TypeString,
 			ValidateDiagFunc: utils.ValidateComputeUserData,
+			DiffSuppressFunc: utils.SuppressUserDataDiff,
 			Optional:         true,
 		},
 		AttrZone: {
@@ -204,8 +231,10 @@ func Resource() *schema.Resource {
 		UpdateContext: rUpdate,
 		DeleteContext: rDelete,
 
+		CustomizeDiff: rCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
-			StateContext: utils.ZonedStateContextFunc,
+			StateContext: rImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -444,6 +473,53 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 	return rRead(ctx, d, meta)
 }
 
+// rImport imports an instance along with all its private network, security
+// group and Elastic IP attachments, which are otherwise only tracked if
+// declared in the resource.
+func rImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := utils.ZonedStateContextFunc(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	clientV3, err := config.GetClientV3WithZone(ctx, meta, d.Get(AttrZone).(string))
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := clientV3.GetInstance(ctx, v3.UUID(d.Id()))
+	if err != nil {
+		return nil, err
+	}
+
+	networkInterfaces := make([]map[string]interface{}, 0, len(instance.PrivateNetworks))
+	for _, privnet := range instance.PrivateNetworks {
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"network_id": privnet.ID.String(),
+		})
+	}
+	if err := d.Set(AttrNetworkInterface, networkInterfaces); err != nil {
+		return nil, err
+	}
+
+	securityGroupIDs := make([]string, 0, len(instance.SecurityGroups))
+	for _, sg := range instance.SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, sg.ID.String())
+	}
+	if err := d.Set(AttrSecurityGroupIDs, securityGroupIDs); err != nil {
+		return nil, err
+	}
+
+	elasticIPIDs := make([]string, 0, len(instance.ElasticIPS))
+	for _, eip := range instance.ElasticIPS {
+		elasticIPIDs = append(elasticIPIDs, eip.ID.String())
+	}
+	if err := d.Set(AttrElasticIPIDs, elasticIPIDs); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func rRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, "beginning read", map[string]interface{}{
 		"id": utils.IDString(d, Name),
@@ -545,6 +621,32 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}
 	}
 
+	// Disk resizing is performed as part of the reset operation if the instance
+	// is being reinstalled, otherwise separately below.
+	resizeDisk := d.HasChange(AttrDiskSize)
+
+	if resizeDisk && *instance.DiskSize > int64(d.Get(AttrDiskSize).(int)) {
+		return diag.Errorf("unable to scale down the disk size, use size > %v", *instance.DiskSize)
+	}
+
+	reset := d.HasChanges(AttrTemplateID, AttrResetTriggers)
+	if reset {
+		opts := []egoscale.ResetInstanceOpt{
+			egoscale.ResetInstanceWithTemplate(&egoscale.Template{
+				ID: utils.NonEmptyStringPtr(d.Get(AttrTemplateID).(string)),
+			}),
+		}
+
+		if resizeDisk {
+			opts = append(opts, egoscale.ResetInstanceWithDiskSize(int64(d.Get(AttrDiskSize).(int))))
+			resizeDisk = false
+		}
+
+		if err := client.ResetInstance(ctx, zone, instance, opts...); err != nil {
+			return diag.Errorf("unable to reset instance: %s", err)
+		}
+	}
+
 	if d.HasChange(AttrReverseDNS) {
 		rdns := d.Get(AttrReverseDNS).(string)
 		if rdns == "" {
@@ -747,27 +849,21 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}
 	}
 
+	var restarted bool
 	if d.HasChanges(
 		AttrState,
-		AttrDiskSize,
 		AttrType,
-	) {
-		// Check if size is below current size to prevent uneeded stop as API will prevent the scale operation
-		if d.HasChange(AttrDiskSize) &&
-			*instance.DiskSize > int64(d.Get(AttrDiskSize).(int)) {
-			return diag.Errorf("unable to scale down the disk size, use size > %v", *instance.DiskSize)
-		}
-
+	) || resizeDisk {
 		// Compute instance scaling/disk resizing API operations requires the instance to be stopped.
 		if d.Get(AttrState) == "stopped" ||
-			d.HasChange(AttrDiskSize) ||
+			resizeDisk ||
 			d.HasChange(AttrType) {
 			if err := client.StopInstance(ctx, zone, instance); err != nil {
 				return diag.Errorf("unable to stop instance: %s", err)
 			}
 		}
 
-		if d.HasChange(AttrDiskSize) {
+		if resizeDisk {
 			if err = client.ResizeInstanceDisk(
 				ctx,
 				zone,
@@ -792,6 +888,15 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 			if err := client.StartInstance(ctx, zone, instance); err != nil {
 				return diag.Errorf("unable to start instance: %s", err)
 			}
+			restarted = true
+		}
+	}
+
+	// A reboot is superfluous if the instance has just been reinstalled or
+	// restarted, and impossible if it is stopped.
+	if d.HasChange(AttrRebootTriggers) && !reset && !restarted && d.Get(AttrState) == "running" {
+		if err := client.RebootInstance(ctx, zone, instance); err != nil {
+			return diag.Errorf("unable to reboot instance: %s", err)
 		}
 	}
 
@@ -857,6 +962,56 @@ func rDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 	return nil
 }
 
+// rCustomizeDiff validates the instance type against the zone catalogue,
+// forces the re-creation of the instance upon template change, unless the
+// practitioner opted for an in-place reinstallation, and rejects changes
+// requiring to stop the instance if the practitioner disallowed it.
+func rCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := instance_type.CustomizeDiff(ctx, d, meta, AttrZone, AttrType); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	reset := d.HasChange(AttrResetTriggers)
+	if d.HasChange(AttrTemplateID) {
+		if d.Get(AttrTemplateChangeStrategy).(string) != TemplateChangeStrategyReset {
+			return d.ForceNew(AttrTemplateID)
+		}
+		reset = true
+	}
+
+	// Disk resizing is folded into the reset operation, which doesn't
+	// require the instance to be stopped beforehand.
+	stopRequired := d.HasChange(AttrType) || (d.HasChange(AttrDiskSize) && !reset)
+	if stopRequired && d.Get(AttrState).(string) != "stopped" && !allowStoppingForUpdate(d) {
+		return fmt.Errorf(
+			"changing %q or %q requires stopping the instance, which is disallowed by %q: "+
+				"set it to true or stop the instance first",
+			AttrType,
+			AttrDiskSize,
+			AttrAllowStoppingForUpdate,
+		)
+	}
+
+	return nil
+}
+
+// allowStoppingForUpdate returns the configured value of the
+// allow_stopping_for_update attribute, defaulting to true if unset.
+// It has no schema default, which would show a diff on the states
+// predating the attribute.
+func allowStoppingForUpdate(d *schema.ResourceDiff) bool {
+	v := d.GetRawConfig().GetAttr(AttrAllowStoppingForUpdate)
+	if v.IsNull() || !v.IsKnown() {
+		return true
+	}
+
+	return v.True()
+}
+
 func rApply( //nolint:gocyclo
 	ctx context.Context,
 	clientV3 *v3.Client,
@@ -895,15 +1050,18 @@ func rApply( //nolint:gocyclo
 		return diag.FromErr(err)
 	}
 
-	if len(instance.ElasticIPS) > 0 {
-		elasticIPIDs := make([]string, 0, len(instance.ElasticIPS))
-		for _, eip := range instance.ElasticIPS {
+	// Only the Elastic IPs declared in the resource are tracked, so that
+	// attachments managed separately (e.g. using the
+	// exoscale_elastic_ip_attachment resource) are left alone.
+	managedElasticIPIDs := d.Get(AttrElasticIPIDs).(*schema.Set)
+	elasticIPIDs := make([]string, 0, len(instance.ElasticIPS))
+	for _, eip := range instance.ElasticIPS {
+		if managedElasticIPIDs.Contains(eip.ID.String()) {
 			elasticIPIDs = append(elasticIPIDs, eip.ID.String())
 		}
-
-		if err := d.Set(AttrElasticIPIDs, elasticIPIDs); err != nil {
-			return diag.FromErr(err)
-		}
+	}
+	if err := d.Set(AttrElasticIPIDs, elasticIPIDs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set(AttrIPv6, utils.DefaultBool(v3.Ptr(instance.Ipv6Address != ""), false)); err != nil {
@@ -930,39 +1088,56 @@ func rApply( //nolint:gocyclo
 		return diag.FromErr(err)
 	}
 
-	if len(instance.PrivateNetworks) > 0 {
-		privateNetworkIDs := make([]string, len(instance.PrivateNetworks))
-		networkInterfaces := make([]map[string]interface{}, len(instance.PrivateNetworks))
-
-		for i, privnet := range instance.PrivateNetworks {
-			privateNetwork, err := clientV3.GetPrivateNetwork(ctx, privnet.ID)
+	// Only the network interfaces declared in the resource are tracked, so that
+	// private network attachments managed separately (e.g. using the
+	// exoscale_private_network_attachment resource) are left alone.
+	managedNetworkIDs := map[string]struct{}{}
+	if nifSet, ok := d.Get(AttrNetworkInterface).(*schema.Set); ok {
+		for _, nif := range nifSet.List() {
+			nif, err := NewNetworkInterface(nif)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
-			var instanceAddress *string
-			for _, lease := range privateNetwork.Leases {
-				if lease.InstanceID.String() == instance.ID.String() {
-					address := lease.IP.String()
-					instanceAddress = &address
-					break
-				}
-			}
+			managedNetworkIDs[nif.NetworkID] = struct{}{}
+		}
+	}
 
-			nif, err := NetworkInterface{privnet.ID.String(), instanceAddress, privnet.MACAddress}.ToInterface()
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	privateNetworkIDs := make([]string, 0, len(instance.PrivateNetworks))
+	networkInterfaces := make([]map[string]interface{}, 0, len(managedNetworkIDs))
+	for _, privnet := range instance.PrivateNetworks {
+		privateNetworkIDs = append(privateNetworkIDs, privnet.ID.String())
 
-			networkInterfaces[i] = nif
-			privateNetworkIDs[i] = privnet.ID.String()
+		if _, ok := managedNetworkIDs[privnet.ID.String()]; !ok {
+			continue
 		}
-		if err := d.Set(AttrPrivateNetworkIDs, privateNetworkIDs); err != nil {
+
+		privateNetwork, err := clientV3.GetPrivateNetwork(ctx, privnet.ID)
+		if err != nil {
 			return diag.FromErr(err)
 		}
-		if err := d.Set(AttrNetworkInterface, networkInterfaces); err != nil {
+
+		var instanceAddress *string
+		for _, lease := range privateNetwork.Leases {
+			if lease.InstanceID.String() == instance.ID.String() {
+				address := lease.IP.String()
+				instanceAddress = &address
+				break
+			}
+		}
+
+		nif, err := NetworkInterface{privnet.ID.String(), instanceAddress, privnet.MACAddress}.ToInterface()
+		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		networkInterfaces = append(networkInterfaces, nif)
+	}
+	if err := d.Set(AttrPrivateNetworkIDs, privateNetworkIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(AttrNetworkInterface, networkInterfaces); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if instance.PublicIP != nil {
@@ -977,15 +1152,18 @@ func rApply( //nolint:gocyclo
 		}
 	}
 
-	if len(instance.SecurityGroups) > 0 {
-		securityGroupIDs := make([]string, 0, len(instance.SecurityGroups))
-		for _, sg := range instance.SecurityGroups {
+	// Only the security groups declared in the resource are tracked, so that
+	// attachments managed separately (e.g. using the
+	// exoscale_security_group_attachment resource) are left alone.
+	managedSecurityGroupIDs := d.Get(AttrSecurityGroupIDs).(*schema.Set)
+	securityGroupIDs := make([]string, 0, len(instance.SecurityGroups))
+	for _, sg := range instance.SecurityGroups {
+		if managedSecurityGroupIDs.Contains(sg.ID.String()) {
 			securityGroupIDs = append(securityGroupIDs, sg.ID.String())
 		}
-
-		if err := d.Set(AttrSecurityGroupIDs, securityGroupIDs); err != nil {
-			return diag.FromErr(err)
-		}
+	}
+	if err := d.Set(AttrSecurityGroupIDs, securityGroupIDs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set(AttrState, instance.State); err != nil {
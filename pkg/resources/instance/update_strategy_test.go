@@ -0,0 +1,108 @@
+package instance_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var updateStrategyInstanceResource = `
+data "exoscale_template" "my_template" {
+  zone = "{{.Zone}}"
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_compute_instance" "my_instance" {
+  zone = "{{.Zone}}"
+  name = "{{.Name}}"
+
+  template_id               = data.exoscale_template.my_template.id
+  type                      = "{{.Type}}"
+  disk_size                 = 10
+  allow_stopping_for_update = false
+
+  reboot_triggers = {
+    revision = "{{.Revision}}"
+  }
+}
+`
+
+var (
+	updateStrategyTmpl          = template.Must(template.New("compute_instance").Parse(updateStrategyInstanceResource))
+	stoppingForUpdateDisallowed = regexp.MustCompile(`requires stopping the instance, which is disallowed`)
+	invalidInstanceType         = regexp.MustCompile(`did you mean "standard.medium"\?`)
+)
+
+type updateStrategyTestData struct {
+	Zone     string
+	Name     string
+	Type     string
+	Revision string
+}
+
+func buildUpdateStrategyTestConfig(t *testing.T, testData updateStrategyTestData) string {
+	var tmplBuf bytes.Buffer
+
+	err := updateStrategyTmpl.Execute(&tmplBuf, testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tmplBuf.String()
+}
+
+func testUpdateStrategy(t *testing.T) {
+	var instanceID string
+
+	data := updateStrategyTestData{
+		Zone:     testutils.TestZoneName,
+		Name:     acctest.RandomWithPrefix(testutils.Prefix),
+		Type:     "standard.micro",
+		Revision: "1",
+	}
+
+	typeMisspelled := data
+	typeMisspelled.Type = "standard.medum"
+
+	typeUpdated := data
+	typeUpdated.Type = "standard.tiny"
+
+	triggersUpdated := data
+	triggersUpdated.Revision = "2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				// test that an unknown instance type is refused at plan time
+				Config:      buildUpdateStrategyTestConfig(t, typeMisspelled),
+				PlanOnly:    true,
+				ExpectError: invalidInstanceType,
+			},
+			{
+				Config: buildUpdateStrategyTestConfig(t, data),
+				Check:  checkInstanceNotReplaced(&instanceID),
+			},
+			{
+				// test that scaling the instance is refused at plan time
+				Config:      buildUpdateStrategyTestConfig(t, typeUpdated),
+				ExpectError: stoppingForUpdateDisallowed,
+			},
+			{
+				// test that changing reboot_triggers reboots the instance in place
+				Config: buildUpdateStrategyTestConfig(t, triggersUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					checkInstanceNotReplaced(&instanceID),
+					resource.TestCheckResourceAttr("exoscale_compute_instance.my_instance", "state", "running"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,16 @@
+package instance_console
+
+const (
+	Name = "exoscale_compute_instance_console"
+
+	AttrHost            = "host"
+	attrHostDescription = "The console proxy host."
+	AttrID              = "id"
+	attrIDDescription   = "The [exoscale_compute_instance](../resources/compute_instance.md) (ID) to get the console of."
+	AttrPath            = "path"
+	attrPathDescription = "The console proxy websocket path."
+	AttrURL             = "url"
+	attrURLDescription  = "The signed console proxy URL, valid for 60 seconds."
+	AttrZone            = "zone"
+	attrZoneDescription = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
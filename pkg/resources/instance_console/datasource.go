@@ -0,0 +1,179 @@
+package instance_console
+
+import (
+	"context"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceDescription = `Fetch a signed URL to the VNC console of an Exoscale [Compute Instance](https://community.exoscale.com/documentation/compute/),
+through the Exoscale console proxy websocket.
+
+The URL grants access to the instance console and is only valid for 60 seconds after the data source is read:
+it is marked as sensitive, and should be consumed right away (e.g. by a runbook output) rather than stored.
+
+Corresponding resource: [exoscale_compute_instance](../resources/compute_instance.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSource{}
+
+// DataSource defines the data source implementation.
+type DataSource struct {
+	client *exoscale.Client
+}
+
+// NewDataSource creates instance of DataSource.
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSourceModel defines the data source data model.
+type DataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Host types.String `tfsdk:"host"`
+	Path types.String `tfsdk:"path"`
+	URL  types.String `tfsdk:"url"`
+	Zone types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_compute_instance_console"
+}
+
+// Schema defines data source attributes.
+func (d *DataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrIDDescription,
+				Required:            true,
+			},
+			AttrHost: schema.StringAttribute{
+				MarkdownDescription: attrHostDescription,
+				Computed:            true,
+			},
+			AttrPath: schema.StringAttribute{
+				MarkdownDescription: attrPathDescription,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			AttrURL: schema.StringAttribute{
+				MarkdownDescription: attrURLDescription,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up data source dependencies.
+func (d *DataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(data.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse instance ID",
+			err.Error(),
+		)
+		return
+	}
+
+	console, err := client.GetConsoleProxyURL(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get instance console proxy URL",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Host = types.StringValue(console.Host)
+	data.Path = types.StringValue(console.Path)
+	data.URL = types.StringValue(console.URL)
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
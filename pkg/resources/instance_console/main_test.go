@@ -0,0 +1,37 @@
+package instance_console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestComputeInstanceConsole(t *testing.T) {
+	dataSourceName := "data.exoscale_compute_instance_console.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.compute_instance_console.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "id",
+						"exoscale_compute_instance.test_instance", "id",
+					),
+					resource.TestCheckResourceAttrSet(dataSourceName, "host"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "url"),
+				),
+			},
+		},
+	})
+}
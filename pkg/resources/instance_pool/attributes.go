@@ -4,32 +4,47 @@ const (
 	Name     = "exoscale_instance_pool"
 	NameList = "exoscale_instance_pool_list"
 
-	AttrAffinityGroupIDs        = "affinity_group_ids"
-	AttrAntiAffinityGroupIDs    = "anti_affinity_group_ids"
-	AttrDeployTargetID          = "deploy_target_id"
-	AttrDescription             = "description"
-	AttrDiskSize                = "disk_size"
-	AttrElasticIPIDs            = "elastic_ip_ids"
-	AttrInstancePrefix          = "instance_prefix"
-	AttrInstanceType            = "instance_type"
-	AttrIPv6                    = "ipv6"
-	AttrKeyPair                 = "key_pair"
-	AttrLabels                  = "labels"
-	AttrID                      = "id"
-	AttrName                    = "name"
-	AttrNetworkIDs              = "network_ids"
-	AttrServiceOffering         = "service_offering"
-	AttrSecurityGroupIDs        = "security_group_ids"
-	AttrSize                    = "size"
-	AttrMinAvailable            = "min_available"
-	AttrState                   = "state"
-	AttrTemplateID              = "template_id"
-	AttrUserData                = "user_data"
-	AttrInstances               = "instances"
-	AttrInstanceID              = "id"
-	AttrInstanceIPv6Address     = "ipv6_address"
-	AttrInstanceName            = "name"
-	AttrInstancePublicIPAddress = "public_ip_address"
-	AttrVirtualMachines         = "virtual_machines"
-	AttrZone                    = "zone"
+	AttrAffinityGroupIDs                  = "affinity_group_ids"
+	AttrAntiAffinityGroupIDs              = "anti_affinity_group_ids"
+	AttrDeployTargetID                    = "deploy_target_id"
+	AttrDescription                       = "description"
+	AttrDiskSize                          = "disk_size"
+	AttrElasticIPIDs                      = "elastic_ip_ids"
+	AttrEvictInstanceIDs                  = "evict_instance_ids"
+	AttrInstancePrefix                    = "instance_prefix"
+	AttrInstanceType                      = "instance_type"
+	AttrIPv6                              = "ipv6"
+	AttrKeyPair                           = "key_pair"
+	AttrLabels                            = "labels"
+	AttrID                                = "id"
+	AttrName                              = "name"
+	AttrNetworkIDs                        = "network_ids"
+	AttrRollingUpdate                     = "rolling_update"
+	AttrServiceOffering                   = "service_offering"
+	AttrSecurityGroupIDs                  = "security_group_ids"
+	AttrSize                              = "size"
+	AttrSSHKeys                           = "ssh_keys"
+	AttrMinAvailable                      = "min_available"
+	AttrState                             = "state"
+	AttrTemplateID                        = "template_id"
+	AttrUserData                          = "user_data"
+	AttrInstances                         = "instances"
+	AttrInstanceID                        = "id"
+	AttrInstanceIPv6Address               = "ipv6_address"
+	AttrInstanceName                      = "name"
+	AttrInstancePrivateNetworkIPAddresses = "private_network_ip_addresses"
+	AttrInstancePublicIPAddress           = "public_ip_address"
+	AttrInstanceState                     = "state"
+	AttrVirtualMachines                   = "virtual_machines"
+	AttrWaitForMembers                    = "wait_for_members"
+	AttrZone                              = "zone"
+
+	AttrRollingUpdateMaxUnavailable      = "max_unavailable"
+	AttrRollingUpdatePauseBetweenBatches = "pause_between_batches"
+
+	AttrWaitForMembersTimeout = "timeout"
+
+	AttrWaitForHealthyNLBService          = "wait_for_healthy_nlb_service"
+	AttrWaitForHealthyNLBServiceNLBID     = "nlb_id"
+	AttrWaitForHealthyNLBServiceServiceID = "service_id"
 )
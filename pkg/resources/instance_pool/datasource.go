@@ -74,6 +74,13 @@ func DataSourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 		},
+		AttrSSHKeys: {
+			Description: "The list of [exoscale_ssh_key](../resources/ssh_key.md) (names) authorized on the managed instances.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 		AttrLabels: {
 			Description: "A map of key/value labels.",
 			Type:        schema.TypeMap,
@@ -150,11 +157,22 @@ func DataSourceSchema() map[string]*schema.Schema {
 						Type:        schema.TypeString,
 						Optional:    true,
 					},
+					AttrInstancePrivateNetworkIPAddresses: {
+						Description: "A map of the instance IP addresses in the pool (managed) private networks, by [exoscale_private_network](../resources/private_network.md) ID.",
+						Type:        schema.TypeMap,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Computed:    true,
+					},
 					AttrInstancePublicIPAddress: {
 						Description: "The instance (main network interface) IPv4 address.",
 						Type:        schema.TypeString,
 						Computed:    true,
 					},
+					AttrInstanceState: {
+						Description: "The instance state.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
 				},
 			},
 		},
@@ -261,27 +279,9 @@ func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	)
 
 	if pool.Instances != nil {
-		instancesData := make([]interface{}, len(pool.Instances))
-		for k, i := range pool.Instances {
-			instance, err := client.GetInstance(ctx, i.ID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			var ipv6, publicIp string
-			if instance.Ipv6Address != "" {
-				ipv6 = instance.Ipv6Address
-			}
-			if instance.PublicIP.String() != "" {
-				publicIp = instance.PublicIP.String()
-			}
-
-			instancesData[k] = map[string]interface{}{
-				AttrInstanceID:              id,
-				AttrInstanceIPv6Address:     ipv6,
-				AttrInstanceName:            instance.Name,
-				AttrInstancePublicIPAddress: publicIp,
-			}
+		instancesData, err := membersData(ctx, client, pool)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		data[AttrInstances] = instancesData
@@ -316,6 +316,7 @@ func dsBuildData(pool *v3.InstancePool, zone string) (map[string]interface{}, er
 	if pool.SSHKey != nil {
 		data[AttrKeyPair] = pool.SSHKey.Name
 	}
+	data[AttrSSHKeys] = sshKeyNames(pool.SSHKeys)
 	data[AttrName] = pool.Name
 	data[AttrSize] = pool.Size
 	data[AttrMinAvailable] = pool.MinAvailable
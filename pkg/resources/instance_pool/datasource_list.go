@@ -119,27 +119,9 @@ func dsListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 
 		if pool.Instances != nil {
-			instancesData := make([]interface{}, len(pool.Instances))
-			for k, i := range pool.Instances {
-				instance, err := client.GetInstance(ctx, i.ID)
-				if err != nil {
-					return diag.FromErr(err)
-				}
-
-				var ipv6, publicIp string
-				if instance.Ipv6Address != "" {
-					ipv6 = instance.Ipv6Address
-				}
-				if instance.PublicIP.String() != "" {
-					publicIp = instance.PublicIP.String()
-				}
-
-				instancesData[k] = map[string]interface{}{
-					AttrInstanceID:              i.ID,
-					AttrInstanceIPv6Address:     ipv6,
-					AttrInstanceName:            instance.Name,
-					AttrInstancePublicIPAddress: publicIp,
-				}
+			instancesData, err := membersData(ctx, client, pool)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			poolData[AttrInstances] = instancesData
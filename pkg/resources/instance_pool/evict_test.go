@@ -0,0 +1,108 @@
+package instance_pool_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var rEvictConfig = `
+locals {
+  zone = "%s"
+}
+
+variable "evict_instance_ids" {
+  type    = list(string)
+  default = []
+}
+
+data "exoscale_template" "ubuntu" {
+  zone = local.zone
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_private_network" "test" {
+  zone     = local.zone
+  name     = "%s"
+  start_ip = "10.0.0.20"
+  end_ip   = "10.0.0.253"
+  netmask  = "255.255.255.0"
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone               = local.zone
+  name               = "%s"
+  template_id        = data.exoscale_template.ubuntu.id
+  instance_type      = "standard.tiny"
+  size               = %d
+  disk_size          = 10
+  network_ids        = [exoscale_private_network.test.id]
+  evict_instance_ids = var.evict_instance_ids
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`
+
+func testEvict(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		instancePool v3.InstancePool
+		evicted      v3.UUID
+		kept         v3.UUID
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: fmt.Sprintf(rEvictConfig, testutils.TestZoneName, name, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						require.Len(t, instancePool.Instances, 2)
+
+						evicted = instancePool.Instances[0].ID
+						kept = instancePool.Instances[1].ID
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(r, "instances.0.state", "running"),
+					resource.TestCheckResourceAttr(r, "instances.0.private_network_ip_addresses.%", "1"),
+				),
+			},
+			{
+				// Evict a specific member while scaling down
+				PreConfig: func() {
+					t.Setenv("TF_VAR_evict_instance_ids", fmt.Sprintf(`["%s"]`, evicted))
+				},
+				Config: fmt.Sprintf(rEvictConfig, testutils.TestZoneName, name, name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Len(instancePool.Instances, 1)
+						a.Equal(kept, instancePool.Instances[0].ID)
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(r, "size", "1"),
+				),
+			},
+		},
+	})
+}
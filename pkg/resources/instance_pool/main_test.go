@@ -6,4 +6,8 @@ func TestInstancePool(t *testing.T) {
 	t.Run("DataSource", testDataSource)
 	t.Run("DataSourceList", testListDataSource)
 	t.Run("Resource", testResource)
+	t.Run("Evict", testEvict)
+	t.Run("RollingUpdate", testRollingUpdate)
+	t.Run("SSHKeys", testSSHKeys)
+	t.Run("WaitForMembers", testWaitForMembers)
 }
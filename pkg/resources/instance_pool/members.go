@@ -0,0 +1,256 @@
+package instance_pool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+// membersPollInterval is the interval between two checks of the pool members state.
+var membersPollInterval = 5 * time.Second
+
+func waitForMembersSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Wait for all the managed instances to be running before completing the creation or update of the pool. " +
+			"Structure is documented below.",
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				AttrWaitForMembersTimeout: {
+					Description: "How long to wait for the members (e.g. `5m`, `1h`); " +
+						"the wait is still bounded by the resource timeouts.",
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+				},
+				AttrWaitForHealthyNLBService: waitForHealthyNLBServiceSchema(
+					"on all the members as well",
+				),
+			},
+		},
+	}
+}
+
+// waitForMembers waits for the pool members if requested in the "wait_for_members" attribute.
+func waitForMembers(ctx context.Context, client *v3.Client, d *schema.ResourceData) error {
+	if _, ok := d.GetOk(AttrWaitForMembers); !ok {
+		return nil
+	}
+
+	tflog.Debug(ctx, "waiting for instance pool members", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	timeout, _ := time.ParseDuration(d.Get(AttrWaitForMembers + ".0." + AttrWaitForMembersTimeout).(string))
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	nlbID, nlbServiceID := healthyNLBService(d, AttrWaitForMembers+".0."+AttrWaitForHealthyNLBService)
+
+	return waitForPoolMembers(ctx, client, v3.UUID(d.Id()), int64(d.Get(AttrSize).(int)), nil, nlbID, nlbServiceID)
+}
+
+// membersData returns the details of the instance pool members, as expected by the "instances" attribute.
+func membersData(ctx context.Context, client *v3.Client, pool *v3.InstancePool) ([]interface{}, error) {
+	// Private network IP addresses are only known from the (managed) private networks leases.
+	leases := make(map[v3.UUID]map[string]interface{})
+	for _, pn := range pool.PrivateNetworks {
+		privateNetwork, err := client.GetPrivateNetwork(ctx, pn.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve private network: %w", err)
+		}
+
+		for _, lease := range privateNetwork.Leases {
+			if _, ok := leases[lease.InstanceID]; !ok {
+				leases[lease.InstanceID] = make(map[string]interface{})
+			}
+			leases[lease.InstanceID][pn.ID.String()] = lease.IP.String()
+		}
+	}
+
+	data := make([]interface{}, len(pool.Instances))
+	for k, i := range pool.Instances {
+		instance, err := client.GetInstance(ctx, i.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		data[k] = computeInstanceToResource(instance, leases[instance.ID])
+	}
+
+	return data, nil
+}
+
+func computeInstanceToResource(instance *v3.Instance, privateNetworkIPAddresses map[string]interface{}) interface{} {
+	c := make(map[string]interface{})
+	c[AttrInstanceID] = instance.ID
+	c[AttrInstanceIPv6Address] = instance.Ipv6Address
+	c[AttrInstanceName] = instance.Name
+	c[AttrInstancePrivateNetworkIPAddresses] = privateNetworkIPAddresses
+	c[AttrInstancePublicIPAddress] = utils.AddressToStringPtr(&instance.PublicIP)
+	c[AttrInstanceState] = instance.State
+	return c
+}
+
+// evictMembers evicts the pool members listed in the "evict_instance_ids" attribute, and returns
+// how many were evicted.
+func evictMembers(ctx context.Context, client *v3.Client, d *schema.ResourceData) (int64, error) {
+	if !d.HasChange(AttrEvictInstanceIDs) {
+		return 0, nil
+	}
+
+	pool, err := client.GetInstancePool(ctx, v3.UUID(d.Id()))
+	if err != nil {
+		return 0, err
+	}
+
+	requested := d.Get(AttrEvictInstanceIDs).(*schema.Set)
+	members := []v3.UUID{}
+	for _, member := range pool.Instances {
+		if requested.Contains(member.ID.String()) {
+			members = append(members, member.ID)
+		}
+	}
+
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	tflog.Debug(ctx, "evicting instance pool members", map[string]interface{}{
+		"id":      utils.IDString(d, Name),
+		"members": members,
+	})
+
+	if err := evict(ctx, client, pool.ID, members); err != nil {
+		return 0, err
+	}
+
+	return int64(len(members)), nil
+}
+
+func evict(ctx context.Context, client *v3.Client, poolID v3.UUID, members []v3.UUID) error {
+	op, err := client.EvictInstancePoolMembers(ctx, poolID, v3.EvictInstancePoolMembersRequest{
+		Instances: members,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to evict instance pool members: %w", err)
+	}
+	if _, err = client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+		return fmt.Errorf("unable to evict instance pool members: %w", err)
+	}
+
+	return nil
+}
+
+// waitForPoolMembers waits until the pool has the expected number of running members and, if an NLB
+// service is specified, until its healthcheck succeeds on every member not part of the outdated ones.
+func waitForPoolMembers(
+	ctx context.Context,
+	client *v3.Client,
+	poolID v3.UUID,
+	size int64,
+	outdated []v3.UUID,
+	nlbID, nlbServiceID v3.UUID,
+) error {
+	ticker := time.NewTicker(membersPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ready, err := poolMembersReady(ctx, client, poolID, size, outdated, nlbID, nlbServiceID)
+			if err != nil {
+				return err
+			}
+			if ready {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for instance pool members: %w", ctx.Err())
+		}
+	}
+}
+
+func poolMembersReady(
+	ctx context.Context,
+	client *v3.Client,
+	poolID v3.UUID,
+	size int64,
+	outdated []v3.UUID,
+	nlbID, nlbServiceID v3.UUID,
+) (bool, error) {
+	pool, err := client.GetInstancePool(ctx, poolID)
+	if err != nil {
+		return false, err
+	}
+
+	if int64(len(pool.Instances)) != size {
+		return false, nil
+	}
+
+	replacements := []*v3.Instance{}
+	for _, member := range pool.Instances {
+		instance, err := client.GetInstance(ctx, member.ID)
+		if err != nil {
+			return false, err
+		}
+
+		if instance.State != v3.InstanceStateRunning {
+			return false, nil
+		}
+
+		if !containsUUID(outdated, instance.ID) {
+			replacements = append(replacements, instance)
+		}
+	}
+
+	if nlbServiceID == "" {
+		return true, nil
+	}
+
+	service, err := client.GetLoadBalancerService(ctx, nlbID, nlbServiceID)
+	if err != nil {
+		return false, err
+	}
+
+	healthy := make(map[string]bool, len(service.HealthcheckStatus))
+	for _, status := range service.HealthcheckStatus {
+		healthy[status.PublicIP.String()] = status.Status == v3.LoadBalancerServerStatusStatusSuccess
+	}
+
+	for _, instance := range replacements {
+		// The NLB only reports the healthcheck status of its targets by public IP address.
+		if instance.PublicIP == nil {
+			return false, fmt.Errorf(
+				"unable to check the NLB service healthcheck on instance pool member %s: it has no public IP address",
+				instance.ID,
+			)
+		}
+
+		if !healthy[instance.PublicIP.String()] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func containsUUID(list []v3.UUID, id v3.UUID) bool {
+	for _, v := range list {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package instance_pool
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+const (
+	testPoolID       = "3f6c9e1a-2b4d-4c8e-9f0a-1b2c3d4e5f60"
+	testInstanceID   = "8d7c6b5a-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+	testNLBID        = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	testNLBServiceID = "6f5e4d3c-2b1a-4f9e-8d7c-6b5a4f3e2d1c"
+)
+
+func testClient(t *testing.T, publicIP net.IP) *v3.Client {
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	mux.HandleFunc("GET /instance-pool/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, v3.InstancePool{
+			ID:        testPoolID,
+			Instances: []v3.Instance{{ID: testInstanceID}},
+		})
+	})
+	mux.HandleFunc("GET /instance/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, v3.Instance{
+			ID:       testInstanceID,
+			State:    v3.InstanceStateRunning,
+			PublicIP: publicIP,
+		})
+	})
+	mux.HandleFunc("GET /load-balancer/{id}/service/{service}", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, v3.LoadBalancerService{
+			ID: testNLBServiceID,
+			HealthcheckStatus: []v3.LoadBalancerServerStatus{{
+				PublicIP: net.ParseIP("198.51.100.1"),
+				Status:   v3.LoadBalancerServerStatusStatusSuccess,
+			}},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := v3.NewClient(
+		credentials.NewStaticCredentials("EXOtest", "test"),
+		v3.ClientOptWithEndpoint(v3.Endpoint(server.URL)),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestPoolMembersReady(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("healthy", func(t *testing.T) {
+		client := testClient(t, net.ParseIP("198.51.100.1"))
+
+		ready, err := poolMembersReady(ctx, client, testPoolID, 1, nil, testNLBID, testNLBServiceID)
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("no public IP address", func(t *testing.T) {
+		client := testClient(t, nil)
+
+		ready, err := poolMembersReady(ctx, client, testPoolID, 1, nil, "", "")
+		require.NoError(t, err)
+		assert.True(t, ready)
+
+		_, err = poolMembersReady(ctx, client, testPoolID, 1, nil, testNLBID, testNLBServiceID)
+		assert.ErrorContains(t, err, "has no public IP address")
+	})
+}
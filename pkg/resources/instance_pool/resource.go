@@ -14,6 +14,7 @@ import (
 	v3 "github.com/exoscale/egoscale/v3"
 
 	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_type"
 	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
 )
 
@@ -41,7 +42,7 @@ func Resource() *schema.Resource {
 			ConflictsWith: []string{AttrAntiAffinityGroupIDs},
 		},
 		AttrDeployTargetID: {
-			Description: "A deploy target ID.",
+			Description: "A deploy target ID (see the [exoscale_deploy_target](../data-sources/deploy_target.md) data source).",
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
@@ -63,6 +64,15 @@ func Resource() *schema.Resource {
 			Set:         schema.HashString,
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
+		AttrEvictInstanceIDs: {
+			Description: "A list of managed instances (IDs) to evict from the pool. Evicted members are destroyed and the pool shrinks accordingly: " +
+				"decrease `size` by the same number to remove exactly these members, or leave it unchanged to replace them. " +
+				"IDs which are not (or no longer) pool members are ignored.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Set:      schema.HashString,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
 		AttrInstancePrefix: {
 			Description: "The string used to prefix managed instances name (default: `pool`).",
 			Type:        schema.TypeString,
@@ -75,7 +85,7 @@ func Resource() *schema.Resource {
 			//  As soon as the "service_offering" parameter is phased out, the schema must be changed:
 			//  - Optional:true must become Required:true
 			//  - Computed:true must be removed
-			Description:      "The managed compute instances type (`<family>.<size>`, e.g. `standard.medium`; use the [Exoscale CLI](https://github.com/exoscale/cli/) - `exo compute instance-type list` - for the list of available types).",
+			Description:      "The managed compute instances type (`<family>.<size>`, e.g. `standard.medium`; use the [exoscale_compute_instance_type_list](../data-sources/compute_instance_type_list.md) data source for the list of available types, validated at plan time).",
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
@@ -91,9 +101,10 @@ func Resource() *schema.Resource {
 			Default:     false,
 		},
 		AttrKeyPair: {
-			Description: "The [exoscale_ssh_key](./ssh_key.md) (name) to authorize in the managed instances.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:   "The [exoscale_ssh_key](./ssh_key.md) (name) to authorize in the managed instances. Please use the `ssh_keys` argument instead.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{AttrSSHKeys},
 		},
 		AttrLabels: {
 			Description: "A map of key/value labels.",
@@ -113,6 +124,7 @@ func Resource() *schema.Resource {
 			Set:         schema.HashString,
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
+		AttrRollingUpdate: rollingUpdateSchema(),
 		AttrSecurityGroupIDs: {
 			Description: "A list of [exoscale_security_group](./security_group.md) (IDs).",
 			Type:        schema.TypeSet,
@@ -147,15 +159,24 @@ func Resource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		AttrSSHKeys: {
+			Description:   "A list of [exoscale_ssh_key](./ssh_key.md) (names) to authorize in the managed instances.",
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Set:           schema.HashString,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{AttrKeyPair},
+		},
 		AttrTemplateID: {
 			Description: "The [exoscale_template](../data-sources/template.md) (ID) to use when creating the managed instances.",
 			Type:        schema.TypeString,
 			Required:    true,
 		},
 		AttrUserData: {
-			Description: "[cloud-init](http://cloudinit.readthedocs.io/) configuration to apply to the managed instances.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:      "[cloud-init](http://cloudinit.readthedocs.io/) configuration to apply to the managed instances. Use the [exoscale_cloudinit_config](../data-sources/cloudinit_config.md) data source to assemble multi-part documents.",
+			Type:             schema.TypeString,
+			DiffSuppressFunc: utils.SuppressUserDataDiff,
+			Optional:         true,
 		},
 		AttrVirtualMachines: {
 			Description: "The list of managed instances (IDs). Please use the `instances.*.id` attribute instead.",
@@ -187,14 +208,26 @@ func Resource() *schema.Resource {
 						Type:        schema.TypeString,
 						Optional:    true,
 					},
+					AttrInstancePrivateNetworkIPAddresses: {
+						Description: "A map of the instance IP addresses in the pool (managed) private networks, by [exoscale_private_network](./private_network.md) ID.",
+						Type:        schema.TypeMap,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Computed:    true,
+					},
 					AttrInstancePublicIPAddress: {
 						Description: "The instance (main network interface) IPv4 address.",
 						Type:        schema.TypeString,
 						Computed:    true,
 					},
+					AttrInstanceState: {
+						Description: "The instance state.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
 				},
 			},
 		},
+		AttrWaitForMembers: waitForMembersSchema(),
 		AttrZone: {
 			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
 			Type:        schema.TypeString,
@@ -214,6 +247,10 @@ Corresponding data sources: [exoscale_instance_pool](../data-sources/instance_po
 		UpdateContext: rUpdate,
 		DeleteContext: rDelete,
 
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return instance_type.CustomizeDiff(ctx, d, meta, AttrZone, AttrInstanceType)
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: utils.ZonedStateContextFunc,
 		},
@@ -292,6 +329,10 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		createPoolRequest.Labels = labels
 	}
 
+	if set, ok := d.Get(AttrSSHKeys).(*schema.Set); ok && set.Len() > 0 {
+		createPoolRequest.SSHKeys = sshKeysFromSet(set)
+	}
+
 	if v, ok := d.GetOk(AttrSize); ok {
 		i := int64(v.(int))
 		createPoolRequest.Size = i
@@ -429,6 +470,10 @@ func rCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 
 	d.SetId(op.Reference.ID.String())
 
+	if err := waitForMembers(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Debug(ctx, "create finished successfully", map[string]interface{}{
 		"id": utils.IDString(d, Name),
 	})
@@ -499,6 +544,19 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		return diag.FromErr(err)
 	}
 
+	// Members existing before the update keep running the previous configuration:
+	// keep track of them to replace them afterwards.
+	var outdatedMembers []v3.UUID
+	if rollingUpdateNeeded(d) {
+		pool, err := client.GetInstancePool(ctx, v3.UUID(d.Id()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, member := range pool.Instances {
+			outdatedMembers = append(outdatedMembers, member.ID)
+		}
+	}
+
 	updateRequest := v3.UpdateInstancePoolRequest{}
 	var updated bool
 
@@ -591,17 +649,24 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		updated = true
 	}
 
-	// We need to explicitely specify the SSHKey on
+	// We need to explicitely specify the SSH key(s) on
 	// update otherwise the orchestrator will interpret that as
-	// clearing the list of associated SSH key
-	v := d.Get(AttrKeyPair).(string)
-	updateRequest.SSHKey = &v3.SSHKey{
-		Name: v,
+	// clearing the associated SSH keys.
+	if v, ok := d.GetOk(AttrKeyPair); ok {
+		updateRequest.SSHKey = &v3.SSHKey{Name: v.(string)}
 	}
 	if d.HasChange(AttrKeyPair) {
 		updated = true
 	}
 
+	// An empty list (rather than null) clears the SSH keys.
+	if set := d.Get(AttrSSHKeys).(*schema.Set); set.Len() > 0 || d.HasChange(AttrSSHKeys) {
+		updateRequest.SSHKeys = sshKeysFromSet(set)
+	}
+	if d.HasChange(AttrSSHKeys) {
+		updated = true
+	}
+
 	if d.HasChange(AttrLabels) {
 		labels := make(map[string]string)
 		for k, v := range d.Get(AttrLabels).(map[string]interface{}) {
@@ -697,8 +762,14 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}
 	}
 
-	if d.HasChange(AttrSize) {
+	evicted, err := evictMembers(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
+	// Evicting members shrinks the pool: the scaling request is based on the resulting size.
+	previousSize, _ := d.GetChange(AttrSize)
+	if int64(previousSize.(int))-evicted != int64(d.Get(AttrSize).(int)) {
 		op, err := client.ScaleInstancePool(ctx, v3.UUID(d.Id()), v3.ScaleInstancePoolRequest{
 			Size: int64(d.Get(AttrSize).(int)),
 		})
@@ -711,6 +782,16 @@ func rUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 		}
 	}
 
+	if len(outdatedMembers) > 0 {
+		if err := rollingUpdate(ctx, client, d, outdatedMembers); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := waitForMembers(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tflog.Debug(ctx, "update finished successfully", map[string]interface{}{
 		"id": utils.IDString(d, Name),
 	})
@@ -821,10 +902,8 @@ func rApply(ctx context.Context, client *v3.Client, d *schema.ResourceData, pool
 		return diag.FromErr(err)
 	}
 
-	if pool.SSHKey != nil {
-		if err := d.Set(AttrKeyPair, pool.SSHKey.Name); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := applySSHKeys(d, pool); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set(AttrLabels, pool.Labels); err != nil {
@@ -905,18 +984,13 @@ func rApply(ctx context.Context, client *v3.Client, d *schema.ResourceData, pool
 
 	if pool.Instances != nil {
 		instanceIDs := make([]string, len(pool.Instances))
-		instanceDetails := make([]interface{}, len(pool.Instances))
-
 		for k, i := range pool.Instances {
 			instanceIDs[k] = i.ID.String()
+		}
 
-			// instance details
-			instance, err := client.GetInstance(ctx, i.ID)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			instanceDetails[k] = computeInstanceToResource(instance)
+		instanceDetails, err := membersData(ctx, client, pool)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		if err := d.Set(AttrVirtualMachines, instanceIDs); err != nil {
@@ -931,11 +1005,41 @@ func rApply(ctx context.Context, client *v3.Client, d *schema.ResourceData, pool
 	return nil
 }
 
-func computeInstanceToResource(instance *v3.Instance) interface{} {
-	c := make(map[string]interface{})
-	c[AttrInstanceID] = instance.ID
-	c[AttrInstanceIPv6Address] = instance.Ipv6Address
-	c[AttrInstanceName] = instance.Name
-	c[AttrInstancePublicIPAddress] = utils.AddressToStringPtr(&instance.PublicIP)
-	return c
+// applySSHKeys sets the SSH keys of the pool in the state. The single SSH key is also reported
+// in the SSH keys list (and conversely), so only the attribute in use is tracked. When none is
+// (e.g. on import), "ssh_keys" is used if the pool has more than one key.
+func applySSHKeys(d *schema.ResourceData, pool *v3.InstancePool) error {
+	_, useKeyPair := d.GetOk(AttrKeyPair)
+	_, useSSHKeys := d.GetOk(AttrSSHKeys)
+	if !useKeyPair && !useSSHKeys {
+		useSSHKeys = len(pool.SSHKeys) > 1
+	}
+
+	if !useSSHKeys {
+		if pool.SSHKey != nil {
+			return d.Set(AttrKeyPair, pool.SSHKey.Name)
+		}
+
+		return nil
+	}
+
+	return d.Set(AttrSSHKeys, sshKeyNames(pool.SSHKeys))
+}
+
+func sshKeysFromSet(set *schema.Set) []v3.SSHKey {
+	list := make([]v3.SSHKey, set.Len())
+	for i, v := range set.List() {
+		list[i] = v3.SSHKey{Name: v.(string)}
+	}
+
+	return list
+}
+
+func sshKeyNames(keys []v3.SSHKey) []string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.Name
+	}
+
+	return names
 }
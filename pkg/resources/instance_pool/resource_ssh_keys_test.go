@@ -0,0 +1,68 @@
+package instance_pool
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func TestApplySSHKeys(t *testing.T) {
+	one := &v3.InstancePool{
+		SSHKey:  &v3.SSHKey{Name: "a"},
+		SSHKeys: []v3.SSHKey{{Name: "a"}},
+	}
+	two := &v3.InstancePool{
+		SSHKey:  &v3.SSHKey{Name: "a"},
+		SSHKeys: []v3.SSHKey{{Name: "a"}, {Name: "b"}},
+	}
+
+	tests := []struct {
+		name        string
+		raw         map[string]interface{}
+		pool        *v3.InstancePool
+		wantKeyPair string
+		wantSSHKeys []interface{}
+	}{
+		{
+			name:        "import single key",
+			pool:        one,
+			wantKeyPair: "a",
+			wantSSHKeys: []interface{}{},
+		},
+		{
+			name:        "import several keys",
+			pool:        two,
+			wantSSHKeys: []interface{}{"a", "b"},
+		},
+		{
+			name:        "key_pair",
+			raw:         map[string]interface{}{AttrKeyPair: "a"},
+			pool:        one,
+			wantKeyPair: "a",
+			wantSSHKeys: []interface{}{},
+		},
+		{
+			name:        "ssh_keys with a single key",
+			raw:         map[string]interface{}{AttrSSHKeys: []interface{}{"a"}},
+			pool:        one,
+			wantSSHKeys: []interface{}{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Resource().TestResourceData()
+			for k, v := range tt.raw {
+				require.NoError(t, d.Set(k, v))
+			}
+
+			require.NoError(t, applySSHKeys(d, tt.pool))
+			assert.Equal(t, tt.wantKeyPair, d.Get(AttrKeyPair))
+			assert.ElementsMatch(t, tt.wantSSHKeys, d.Get(AttrSSHKeys).(*schema.Set).List())
+		})
+	}
+}
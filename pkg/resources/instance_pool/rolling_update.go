@@ -0,0 +1,178 @@
+package instance_pool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func rollingUpdateSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Replace the existing members in batches when `template_id` or `user_data` change, " +
+			"so that they run the new configuration (by default, only new members do). Structure is documented below.",
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				AttrRollingUpdateMaxUnavailable: {
+					Description:  "The number of members to evict at once (default: `1`).",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				AttrRollingUpdatePauseBetweenBatches: {
+					Description:      "A duration to wait for between two batches, once the replacement members are running (e.g. `30s`, `5m`).",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+				},
+				AttrWaitForHealthyNLBService: waitForHealthyNLBServiceSchema(
+					"on the replacement members before moving on to the next batch",
+				),
+			},
+		},
+	}
+}
+
+func waitForHealthyNLBServiceSchema(when string) *schema.Schema {
+	return &schema.Schema{
+		Description: "An [exoscale_nlb_service](./nlb_service.md) targeting the pool, whose healthcheck must succeed " +
+			when + " (the members must have a public IP address). As the service references the pool, its ID must be obtained through the " +
+			"[exoscale_nlb_service_list](../data-sources/nlb_service_list.md) data source to avoid a dependency cycle. " +
+			"Structure is documented below.",
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				AttrWaitForHealthyNLBServiceNLBID: {
+					Description: "The [exoscale_nlb](./nlb.md) (ID).",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				AttrWaitForHealthyNLBServiceServiceID: {
+					Description: "The [exoscale_nlb_service](./nlb_service.md) (ID).",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// healthyNLBService returns the NLB and service IDs of a "wait_for_healthy_nlb_service {}" block
+// under the given key, or empty IDs if not set.
+func healthyNLBService(d *schema.ResourceData, key string) (v3.UUID, v3.UUID) {
+	if _, ok := d.GetOk(key); !ok {
+		return "", ""
+	}
+
+	return v3.UUID(d.Get(key + ".0." + AttrWaitForHealthyNLBServiceNLBID).(string)),
+		v3.UUID(d.Get(key + ".0." + AttrWaitForHealthyNLBServiceServiceID).(string))
+}
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("invalid duration %q for %q: %w", v, k, err)}
+	}
+
+	return nil, nil
+}
+
+// rollingUpdateNeeded returns whether existing pool members must be replaced following an update.
+func rollingUpdateNeeded(d *schema.ResourceData) bool {
+	if _, ok := d.GetOk(AttrRollingUpdate); !ok {
+		return false
+	}
+
+	return d.HasChanges(AttrTemplateID, AttrUserData)
+}
+
+// rollingUpdate evicts the given pool members in batches, restoring the pool size and waiting for
+// the replacement members to be running (and healthy, if requested) after each batch.
+func rollingUpdate(ctx context.Context, client *v3.Client, d *schema.ResourceData, members []v3.UUID) error {
+	poolID := v3.UUID(d.Id())
+	size := int64(d.Get(AttrSize).(int))
+
+	batchSize := d.Get(attrRollingUpdate(AttrRollingUpdateMaxUnavailable)).(int)
+
+	var pause time.Duration
+	if v, ok := d.GetOk(attrRollingUpdate(AttrRollingUpdatePauseBetweenBatches)); ok {
+		pause, _ = time.ParseDuration(v.(string))
+	}
+
+	nlbID, nlbServiceID := healthyNLBService(d, attrRollingUpdate(AttrWaitForHealthyNLBService))
+
+	// Some of the members may have been removed by a scale-down of the pool in the meantime.
+	pool, err := client.GetInstancePool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	current := make([]v3.UUID, 0, len(pool.Instances))
+	for _, member := range pool.Instances {
+		current = append(current, member.ID)
+	}
+	remaining := make([]v3.UUID, 0, len(members))
+	for _, id := range members {
+		if containsUUID(current, id) {
+			remaining = append(remaining, id)
+		}
+	}
+	members = remaining
+
+	for start := 0; start < len(members); start += batchSize {
+		batch := members[start:min(start+batchSize, len(members))]
+
+		tflog.Debug(ctx, "evicting instance pool members", map[string]interface{}{
+			"id":      utils.IDString(d, Name),
+			"members": batch,
+		})
+
+		if err := evict(ctx, client, poolID, batch); err != nil {
+			return err
+		}
+
+		// Eviction shrinks the pool: scaling it back up creates the replacement members.
+		op, err := client.ScaleInstancePool(ctx, poolID, v3.ScaleInstancePoolRequest{Size: size})
+		if err != nil {
+			return fmt.Errorf("unable to scale instance pool: %w", err)
+		}
+		if _, err = client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			return fmt.Errorf("unable to scale instance pool: %w", err)
+		}
+
+		if err := waitForPoolMembers(ctx, client, poolID, size, members, nlbID, nlbServiceID); err != nil {
+			return err
+		}
+
+		if pause > 0 && start+batchSize < len(members) {
+			select {
+			case <-time.After(pause):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return nil
+}
+
+// attrRollingUpdate returns an instance_pool resource attribute key formatted for a "rolling_update {}" block.
+func attrRollingUpdate(a string) string {
+	return fmt.Sprintf("%s.0.%s", AttrRollingUpdate, a)
+}
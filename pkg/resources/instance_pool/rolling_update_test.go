@@ -0,0 +1,96 @@
+package instance_pool_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var rRollingUpdateConfig = `
+locals {
+  zone = "%s"
+}
+
+data "exoscale_template" "ubuntu" {
+  zone = local.zone
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone          = local.zone
+  name          = "%s"
+  template_id   = data.exoscale_template.ubuntu.id
+  instance_type = "standard.tiny"
+  size          = 2
+  disk_size     = 10
+  user_data     = "%s"
+
+  rolling_update {
+    max_unavailable       = 1
+    pause_between_batches = "10s"
+  }
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`
+
+func testRollingUpdate(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		instancePool v3.InstancePool
+		members      []v3.UUID
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: fmt.Sprintf(rRollingUpdateConfig, testutils.TestZoneName, name, rUserData),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						require.Len(t, instancePool.Instances, 2)
+
+						for _, instance := range instancePool.Instances {
+							members = append(members, instance.ID)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Update user data: all the existing members must be replaced
+				Config: fmt.Sprintf(rRollingUpdateConfig, testutils.TestZoneName, name, rUserDataUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Len(instancePool.Instances, 2)
+						for _, instance := range instancePool.Instances {
+							a.NotContains(members, instance.ID)
+						}
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(r, "instances.#", "2"),
+				),
+			},
+		},
+	})
+}
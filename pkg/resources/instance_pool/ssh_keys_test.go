@@ -0,0 +1,161 @@
+package instance_pool_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var rSSHKeysConfig = `
+locals {
+  zone = "%s"
+}
+
+data "exoscale_template" "ubuntu" {
+  zone = local.zone
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_ssh_key" "test" {
+  count      = 2
+  name       = "%s-${count.index}"
+  public_key = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB8bfA67mQWv4eGND/XVtPx1JW6RAqafub1lV1EcpB+b test",
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINckvO3WoFRoOh0SD1c1FEsm+gqZ0/rbjZzZlDxDA7Js test",
+  ][count.index]
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone          = local.zone
+  name          = "%s"
+  template_id   = data.exoscale_template.ubuntu.id
+  instance_type = "standard.tiny"
+  size          = 1
+  disk_size     = 10
+  ipv6          = true
+  %s
+
+  timeouts {
+    delete = "10m"
+  }
+}
+
+data "exoscale_instance_pool" "test" {
+  zone = local.zone
+  id   = exoscale_instance_pool.test.id
+}
+`
+
+func testSSHKeys(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		ds           = "data.exoscale_instance_pool.test"
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		instancePool v3.InstancePool
+	)
+
+	config := func(keys string) string {
+		return fmt.Sprintf(rSSHKeysConfig, testutils.TestZoneName, name, name, keys)
+	}
+
+	checkPoolSSHKeys := func(names ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			actual := []string{}
+			for _, key := range instancePool.SSHKeys {
+				actual = append(actual, key.Name)
+			}
+			require.ElementsMatch(t, names, actual)
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ssh_keys = exoscale_ssh_key.test[*].name"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						a := require.New(t)
+
+						a.Len(instancePool.SSHKeys, 2)
+						a.True(*instancePool.Ipv6Enabled)
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(r, "ssh_keys.#", "2"),
+					resource.TestCheckNoResourceAttr(r, "key_pair"),
+					resource.TestCheckResourceAttrSet(r, "instances.0.ipv6_address"),
+					resource.TestCheckResourceAttr(ds, "ssh_keys.#", "2"),
+					resource.TestCheckResourceAttr(ds, "ipv6", "true"),
+					resource.TestCheckResourceAttrSet(ds, "instances.0.ipv6_address"),
+				),
+			},
+			{
+				// Import
+				ResourceName: r,
+				ImportStateIdFunc: func(instancePool *v3.InstancePool) resource.ImportStateIdFunc {
+					return func(*terraform.State) (string, error) {
+						return fmt.Sprintf("%s@%s", instancePool.ID.String(), testutils.TestZoneName), nil
+					}
+				}(&instancePool),
+				ImportState: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					for _, state := range s {
+						if state.ID != instancePool.ID.String() {
+							continue
+						}
+
+						a := require.New(t)
+						a.Equal("2", state.Attributes["ssh_keys.#"])
+						a.Empty(state.Attributes["key_pair"])
+					}
+
+					return nil
+				},
+			},
+			{
+				// Change the SSH keys
+				Config: config("ssh_keys = [exoscale_ssh_key.test[1].name]"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					checkPoolSSHKeys(name+"-1"),
+					resource.TestCheckResourceAttr(r, "ssh_keys.#", "1"),
+					resource.TestCheckNoResourceAttr(r, "key_pair"),
+				),
+			},
+			{
+				// Remove the SSH keys
+				Config: config(""),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					checkPoolSSHKeys(),
+					resource.TestCheckResourceAttr(r, "ssh_keys.#", "0"),
+					resource.TestCheckNoResourceAttr(r, "key_pair"),
+				),
+			},
+			{
+				// Switch to the single SSH key
+				Config: config("key_pair = exoscale_ssh_key.test[0].name"),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					checkPoolSSHKeys(name+"-0"),
+					resource.TestCheckResourceAttr(r, "key_pair", name+"-0"),
+					resource.TestCheckResourceAttr(r, "ssh_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
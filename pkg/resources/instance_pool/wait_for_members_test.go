@@ -0,0 +1,105 @@
+package instance_pool_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+var rWaitForMembersConfig = `
+locals {
+  zone = "%s"
+}
+
+data "exoscale_template" "ubuntu" {
+  zone = local.zone
+  name = "Linux Ubuntu 22.04 LTS 64-bit"
+}
+
+resource "exoscale_instance_pool" "test" {
+  zone          = local.zone
+  name          = "%s"
+  template_id   = data.exoscale_template.ubuntu.id
+  instance_type = "standard.tiny"
+  size          = %d
+  disk_size     = 10
+
+  wait_for_members {
+    timeout = "5m"
+  }
+
+  timeouts {
+    delete = "10m"
+  }
+}
+`
+
+func testWaitForMembers(t *testing.T) {
+	var (
+		r            = "exoscale_instance_pool.test"
+		name         = acctest.RandomWithPrefix(testutils.Prefix)
+		instancePool v3.InstancePool
+	)
+
+	checkMembersRunning := func(s *terraform.State) error {
+		ctx := context.Background()
+		defaultClientV3, err := testutils.APIClientV3()
+		if err != nil {
+			return err
+		}
+		client, err := utils.SwitchClientZone(ctx, defaultClientV3, testutils.TestZoneName)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range instancePool.Instances {
+			instance, err := client.GetInstance(ctx, member.ID)
+			if err != nil {
+				return err
+			}
+
+			require.Equal(t, v3.InstanceStateRunning, instance.State)
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		CheckDestroy:      testutils.CheckInstancePoolDestroy(&instancePool),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: fmt.Sprintf(rWaitForMembersConfig, testutils.TestZoneName, name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					checkMembersRunning,
+				),
+			},
+			{
+				// Scale up
+				Config: fmt.Sprintf(rWaitForMembersConfig, testutils.TestZoneName, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckInstancePoolExists(r, &instancePool),
+					func(s *terraform.State) error {
+						require.Len(t, instancePool.Instances, 2)
+						return nil
+					},
+					checkMembersRunning,
+					resource.TestCheckResourceAttr(r, "instances.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,17 @@
+package instance_type
+
+const (
+	Name     = "exoscale_compute_instance_type"
+	NameList = "exoscale_compute_instance_type_list"
+
+	AttrAuthorized = "authorized"
+	AttrCPUs       = "cpus"
+	AttrFamily     = "family"
+	AttrGPUs       = "gpus"
+	AttrID         = "id"
+	AttrMemory     = "memory"
+	AttrName       = "name"
+	AttrSize       = "size"
+	AttrTypes      = "types"
+	AttrZone       = "zone"
+)
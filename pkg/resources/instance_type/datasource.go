@@ -0,0 +1,162 @@
+package instance_type
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exo "github.com/exoscale/egoscale/v2"
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+// DataSourceSchema returns a schema for a single Compute instance type data source.
+func DataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		AttrAuthorized: {
+			Description: "Whether the instance type is available to the organization (some types require prior authorization).",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		AttrCPUs: {
+			Description: "The number of CPUs.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		AttrFamily: {
+			Description: "The instance type family (e.g. `standard`, `memory`, `gpu3`...).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrGPUs: {
+			Description: "The number of GPUs.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		AttrID: {
+			Description: "The instance type ID to match (conflicts with `name`).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		AttrMemory: {
+			Description: "The amount of memory (bytes).",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		AttrName: {
+			Description: "The instance type name to match, in the `<family>.<size>` format used by [exoscale_compute_instance](../resources/compute_instance.md) (conflicts with `id`).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		AttrSize: {
+			Description: "The instance type size (e.g. `micro`, `small`, `medium`...).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		AttrZone: {
+			Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
+
+func DataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch Exoscale [Compute Instance Types](https://www.exoscale.com/pricing/#compute) data.
+
+Use the ` + "`name`" + ` attribute as the ` + "`type`" + ` of an [exoscale_compute_instance](../resources/compute_instance.md).`,
+		Schema: func() map[string]*schema.Schema {
+			schema := DataSourceSchema()
+
+			schema[AttrID].ConflictsWith = []string{AttrName}
+			schema[AttrName].ConflictsWith = []string{AttrID}
+			schema[AttrName].ValidateDiagFunc = utils.ValidateComputeInstanceType
+			return schema
+		}(),
+		ReadContext: dsRead,
+	}
+}
+
+func dsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, byID := d.GetOk(AttrID)
+	name, byName := d.GetOk(AttrName)
+	if !byID && !byName {
+		return diag.Errorf(
+			"either %s or %s must be specified",
+			AttrName,
+			AttrID,
+		)
+	}
+
+	var instanceType *exo.InstanceType
+	if byID {
+		instanceType, err = client.GetInstanceType(ctx, zone, id.(string))
+	} else {
+		instanceType, err = client.FindInstanceType(ctx, zone, name.(string))
+	}
+	if err != nil {
+		return diag.Errorf("unable to retrieve instance type: %s", err)
+	}
+
+	d.SetId(*instanceType.ID)
+
+	data := dsBuildData(instanceType, zone)
+
+	for key, value := range data {
+		err := d.Set(key, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, Name),
+	})
+
+	return nil
+}
+
+// dsBuildData builds terraform data object from egoscale API struct.
+func dsBuildData(instanceType *exo.InstanceType, zone string) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	data[AttrAuthorized] = utils.DefaultBool(instanceType.Authorized, false)
+	data[AttrCPUs] = instanceType.CPUs
+	data[AttrGPUs] = instanceType.GPUs
+	data[AttrID] = instanceType.ID
+	data[AttrMemory] = instanceType.Memory
+	data[AttrZone] = zone
+
+	if instanceType.Family != nil && instanceType.Size != nil {
+		family := strings.ToLower(*instanceType.Family)
+		size := strings.ToLower(*instanceType.Size)
+
+		data[AttrFamily] = family
+		data[AttrSize] = size
+		data[AttrName] = fmt.Sprintf("%s.%s", family, size)
+	}
+
+	return data
+}
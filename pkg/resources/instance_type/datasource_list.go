@@ -0,0 +1,122 @@
+package instance_type
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	exoapi "github.com/exoscale/egoscale/v2/api"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/filter"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+func DataSourceList() *schema.Resource {
+	ret := &schema.Resource{
+		Description: `List Exoscale [Compute Instance Types](https://www.exoscale.com/pricing/#compute).
+
+Instance types are sorted by increasing memory, then CPUs count, which allows picking the smallest type
+matching some requirements with a ` + "`for`" + ` expression.`,
+		Schema: map[string]*schema.Schema{
+			AttrZone: {
+				Description: "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			AttrTypes: {
+				Description: "The list of [exoscale_compute_instance_type](./compute_instance_type.md).",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: DataSourceSchema(),
+				},
+			},
+		},
+
+		ReadContext: dsListRead,
+	}
+
+	filter.AddFilterAttributes(ret, DataSourceSchema())
+
+	return ret
+}
+
+func dsListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "beginning read", map[string]interface{}{
+		"id": utils.IDString(d, NameList),
+	})
+
+	zone := d.Get(AttrZone).(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	ctx = exoapi.WithEndpoint(ctx, exoapi.NewReqEndpoint(config.GetEnvironment(meta), zone))
+	defer cancel()
+
+	client, err := config.GetClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceTypes, err := client.ListInstanceTypes(ctx, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters, err := filter.CreateFilters(ctx, d, DataSourceSchema())
+	if err != nil {
+		return diag.Errorf("failed to create filter: %q", err)
+	}
+
+	sort.SliceStable(instanceTypes, func(i, j int) bool {
+		mi, mj := utils.DefaultInt64(instanceTypes[i].Memory, 0), utils.DefaultInt64(instanceTypes[j].Memory, 0)
+		if mi != mj {
+			return mi < mj
+		}
+
+		return utils.DefaultInt64(instanceTypes[i].CPUs, 0) < utils.DefaultInt64(instanceTypes[j].CPUs, 0)
+	})
+
+	data := make([]interface{}, 0, len(instanceTypes))
+	ids := make([]string, 0, len(instanceTypes))
+
+	for _, instanceType := range instanceTypes {
+		// we use ID to generate a resource ID, we cannot list instance types without ID.
+		if instanceType.ID == nil {
+			continue
+		}
+
+		ids = append(ids, *instanceType.ID)
+
+		instanceTypeData := dsBuildData(instanceType, zone)
+		if !filter.CheckForMatch(instanceTypeData, filters) {
+			continue
+		}
+
+		data = append(data, instanceTypeData)
+	}
+
+	err = d.Set(AttrTypes, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// by sorting instance type IDs we can generate the same resource ID regardless of the order in which
+	// API returns instance types in the list.
+	sort.Strings(ids)
+
+	d.SetId(fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(ids, "")))))
+
+	tflog.Debug(ctx, "read finished successfully", map[string]interface{}{
+		"id": utils.IDString(d, NameList),
+	})
+
+	return nil
+}
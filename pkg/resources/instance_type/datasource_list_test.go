@@ -0,0 +1,51 @@
+package instance_type_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "exoscale_compute_instance_type_list" "test" {
+  # we omit the zone to trigger an error as the zone attribute must be mandatory.
+}`,
+				ExpectError: regexp.MustCompile("Missing required argument"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_compute_instance_type_list" "test" {
+  zone   = "%s"
+  family = "standard"
+}
+
+locals {
+  smallest_8g = [
+    for t in data.exoscale_compute_instance_type_list.test.types : t.name
+    if t.memory >= 8 * 1024 * 1024 * 1024
+  ][0]
+}
+
+output "smallest_8g" {
+  value = local.smallest_8g
+}`, testutils.TestZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.exoscale_compute_instance_type_list.test", "types.0.name", "standard.micro"),
+					resource.TestCheckResourceAttr("data.exoscale_compute_instance_type_list.test", "types.0.family", "standard"),
+					resource.TestCheckResourceAttr("data.exoscale_compute_instance_type_list.test", "types.0.cpus", "1"),
+					resource.TestCheckOutput("smallest_8g", "standard.large"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package instance_type_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	instance_type "github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_type"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+var dsInstanceTypeAttrs = testutils.TestAttrs{
+	instance_type.AttrAuthorized: testutils.ValidateString("true"),
+	instance_type.AttrCPUs:       testutils.ValidateString("2"),
+	instance_type.AttrFamily:     testutils.ValidateString("standard"),
+	instance_type.AttrGPUs:       testutils.ValidateString("0"),
+	instance_type.AttrID:         validation.ToDiagFunc(validation.IsUUID),
+	instance_type.AttrMemory:     testutils.ValidateString("4294967296"),
+	instance_type.AttrName:       testutils.ValidateString("standard.medium"),
+	instance_type.AttrSize:       testutils.ValidateString("medium"),
+}
+
+func testDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testutils.AccPreCheck(t) },
+		ProviderFactories: testutils.Providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_compute_instance_type" "test" {
+  zone = "%s"
+}`, testutils.TestZoneName),
+				ExpectError: regexp.MustCompile("either name or id must be specified"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_compute_instance_type" "test" {
+  zone = "%s"
+  name = "medium"
+}`, testutils.TestZoneName),
+				ExpectError: regexp.MustCompile(`expected format "FAMILY.SIZE"`),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "exoscale_compute_instance_type" "by-name" {
+  zone = "%s"
+  name = "standard.medium"
+}
+
+data "exoscale_compute_instance_type" "by-id" {
+  zone = "%s"
+  id   = data.exoscale_compute_instance_type.by-name.id
+}`, testutils.TestZoneName, testutils.TestZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					dsTestAttributes("data."+instance_type.Name+".by-name", dsInstanceTypeAttrs),
+					dsTestAttributes("data."+instance_type.Name+".by-id", dsInstanceTypeAttrs),
+				),
+			},
+		},
+	})
+}
+
+func dsTestAttributes(ds string, expected testutils.TestAttrs) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, res := range s.RootModule().Resources {
+			if name == ds {
+				return testutils.CheckResourceAttributes(expected, res.Primary.Attributes)
+			}
+		}
+
+		return errors.New("exoscale_compute_instance_type data source not found in the state")
+	}
+}
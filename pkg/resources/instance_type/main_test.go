@@ -0,0 +1,8 @@
+package instance_type_test
+
+import "testing"
+
+func TestInstanceType(t *testing.T) {
+	t.Run("DataSource", testDataSource)
+	t.Run("DataSourceList", testListDataSource)
+}
@@ -0,0 +1,190 @@
+package instance_type
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v3 "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+)
+
+// catalogue caches the names of the instance types available per
+// environment and zone, so that plan-time validation of several resources
+// only lists instance types once per provider run.
+var catalogue = struct {
+	sync.Mutex
+	names map[string][]string
+}{names: map[string][]string{}}
+
+// ValidateName checks that the Compute instance type name (`<family>.<size>`)
+// is available in zone, returning an error suggesting the closest valid name
+// otherwise. It is meant to be used at plan time: failing to retrieve the
+// instance types catalogue doesn't prevent planning, the API reporting any
+// invalid type at apply time.
+func ValidateName(ctx context.Context, meta interface{}, zone, name string) error {
+	return validateAvailableName(ctx, config.GetEnvironment(meta), zone, name, func() (*v3.Client, error) {
+		return config.GetClientV3WithZone(ctx, meta, zone)
+	})
+}
+
+// ValidateNameWithClient is the equivalent of ValidateName for resources
+// holding an API client rather than the SDK provider meta. client must be
+// configured for zone.
+func ValidateNameWithClient(ctx context.Context, client *v3.Client, environment, zone, name string) error {
+	return validateAvailableName(ctx, environment, zone, name, func() (*v3.Client, error) {
+		return client, nil
+	})
+}
+
+func validateAvailableName(ctx context.Context, environment, zone, name string, getClient func() (*v3.Client, error)) error {
+	names, err := availableNames(ctx, environment, zone, getClient)
+	if err != nil {
+		tflog.Warn(ctx, "unable to retrieve instance types, skipping validation", map[string]interface{}{
+			"zone":  zone,
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	return validateName(names, zone, name)
+}
+
+// CustomizeDiff validates the instance type set in typeAttr against the
+// catalogue of the zone set in zoneAttr, when it is known and has changed.
+func CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, zoneAttr, typeAttr string) error {
+	if !d.HasChange(typeAttr) || !d.NewValueKnown(typeAttr) || !d.NewValueKnown(zoneAttr) {
+		return nil
+	}
+
+	name := d.Get(typeAttr).(string)
+	if name == "" {
+		return nil
+	}
+
+	return ValidateName(ctx, meta, d.Get(zoneAttr).(string), name)
+}
+
+func validateName(names []string, zone, name string) error {
+	normalized := strings.ToLower(name)
+	if !strings.Contains(normalized, ".") {
+		normalized = "standard." + normalized
+	}
+
+	for _, n := range names {
+		if n == normalized {
+			return nil
+		}
+	}
+
+	if suggestion := closestName(names, normalized); suggestion != "" {
+		return fmt.Errorf(
+			"instance type %q is not available in zone %q, did you mean %q?",
+			name,
+			zone,
+			suggestion,
+		)
+	}
+
+	return fmt.Errorf("instance type %q is not available in zone %q", name, zone)
+}
+
+func availableNames(ctx context.Context, environment, zone string, getClient func() (*v3.Client, error)) ([]string, error) {
+	key := environment + "/" + zone
+
+	// The lock is only held to access the cache, so that listing the instance
+	// types of a zone doesn't block the validation in other zones.
+	catalogue.Lock()
+	names, ok := catalogue.names[key]
+	catalogue.Unlock()
+	if ok {
+		return names, nil
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.ListInstanceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names = make([]string, 0, len(res.InstanceTypes))
+	for _, t := range res.InstanceTypes {
+		if len(t.Zones) > 0 && !hasZone(t.Zones, zone) {
+			continue
+		}
+
+		names = append(names, strings.ToLower(fmt.Sprintf("%s.%s", t.Family, t.Size)))
+	}
+	sort.Strings(names)
+
+	// An empty catalogue would reject every instance type: don't trust (nor cache) it.
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no instance types available in zone %q", zone)
+	}
+
+	catalogue.Lock()
+	catalogue.names[key] = names
+	catalogue.Unlock()
+
+	return names, nil
+}
+
+func hasZone(zones []v3.ZoneName, zone string) bool {
+	for _, z := range zones {
+		if string(z) == zone {
+			return true
+		}
+	}
+
+	return false
+}
+
+// closestName returns the name with the smallest edit distance to name,
+// or an empty string if none is close enough to be a plausible typo.
+func closestName(names []string, name string) string {
+	closest, best := "", -1
+	for _, n := range names {
+		if d := editDistance(n, name); best < 0 || d < best {
+			closest, best = n, d
+		}
+	}
+
+	if best < 0 || best > len(name)/2 {
+		return ""
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
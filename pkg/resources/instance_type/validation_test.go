@@ -0,0 +1,97 @@
+package instance_type
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+var testNames = []string{
+	"gpu3.large",
+	"memory.big",
+	"standard.large",
+	"standard.medium",
+	"standard.micro",
+	"standard.small",
+}
+
+func TestValidateNameValid(t *testing.T) {
+	for _, name := range []string{"standard.medium", "Standard.Medium", "medium", "gpu3.large"} {
+		if err := validateName(testNames, "ch-gva-2", name); err != nil {
+			t.Errorf("%q should be valid: %s", name, err)
+		}
+	}
+}
+
+func TestValidateNameSuggestion(t *testing.T) {
+	err := validateName(testNames, "ch-gva-2", "standard.medum")
+	if err == nil {
+		t.Fatal("standard.medum should be invalid")
+	}
+
+	if !strings.Contains(err.Error(), `did you mean "standard.medium"?`) {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestValidateNameNoSuggestion(t *testing.T) {
+	err := validateName(testNames, "ch-gva-2", "foo.bar")
+	if err == nil {
+		t.Fatal("foo.bar should be invalid")
+	}
+
+	if strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("unexpected suggestion: %s", err)
+	}
+}
+
+func TestAvailableNames(t *testing.T) {
+	var (
+		requests      int
+		instanceTypes []v3.InstanceType
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v3.ListInstanceTypesResponse{InstanceTypes: instanceTypes})
+	}))
+	defer server.Close()
+
+	client, err := v3.NewClient(
+		credentials.NewStaticCredentials("EXOtest", "test"),
+		v3.ClientOptWithEndpoint(v3.Endpoint(server.URL)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getClient := func() (*v3.Client, error) { return client, nil }
+
+	// An empty catalogue is an error, and isn't cached.
+	if _, err := availableNames(context.Background(), "test", "ch-gva-2", getClient); err == nil {
+		t.Fatal("an empty catalogue should be an error")
+	}
+
+	instanceTypes = []v3.InstanceType{
+		{Family: "standard", Size: "medium"},
+		{Family: "gpu3", Size: "large", Zones: []v3.ZoneName{"de-fra-1"}},
+	}
+	for i := 0; i < 2; i++ {
+		names, err := availableNames(context.Background(), "test", "ch-gva-2", getClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 1 || names[0] != "standard.medium" {
+			t.Errorf("unexpected names: %v", names)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("the catalogue should have been listed twice, got %d", requests)
+	}
+}
@@ -0,0 +1,17 @@
+package private_network_attachment
+
+const (
+	Name = "exoscale_private_network_attachment"
+
+	AttrID                          = "id"
+	AttrInstanceID                  = "instance_id"
+	attrInstanceIDDescription       = "The [exoscale_compute_instance](./compute_instance.md) (ID) to attach to the private network."
+	AttrIPAddress                   = "ip_address"
+	attrIPAddressDescription        = "The IPv4 address to request as static DHCP lease if the private network is *managed* (updated in place)."
+	AttrMACAddress                  = "mac_address"
+	attrMACAddressDescription       = "The MAC address of the instance network interface."
+	AttrPrivateNetworkID            = "private_network_id"
+	attrPrivateNetworkIDDescription = "The [exoscale_private_network](./private_network.md) (ID) to attach the instance to."
+	AttrZone                        = "zone"
+	attrZoneDescription             = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
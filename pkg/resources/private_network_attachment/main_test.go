@@ -0,0 +1,73 @@
+package private_network_attachment_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestPrivateNetworkAttachment(t *testing.T) {
+	managedResourceName := "exoscale_private_network_attachment.test_managed"
+	unmanagedResourceName := "exoscale_private_network_attachment.test_unmanaged"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	var macAddress string
+
+	importStateIDFunc := func(r string) resource.ImportStateIdFunc {
+		return func(s *terraform.State) (string, error) {
+			return fmt.Sprintf("%s@%s", s.RootModule().Resources[r].Primary.ID, testdataSpec.Zone), nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1 Create attachments (the instance must not plan to detach them)
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.private_network_attachment_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(managedResourceName, "ip_address", "10.0.0.100"),
+					resource.TestCheckResourceAttrSet(managedResourceName, "mac_address"),
+					resource.TestCheckNoResourceAttr(unmanagedResourceName, "ip_address"),
+					resource.TestCheckResourceAttr("exoscale_compute_instance.test_instance", "network_interface.#", "1"),
+					resource.TestCheckResourceAttr("exoscale_compute_instance.test_instance", "private_network_ids.#", "3"),
+					func(s *terraform.State) error {
+						macAddress = s.RootModule().Resources[managedResourceName].Primary.Attributes["mac_address"]
+						return nil
+					},
+				),
+			},
+			// 2 Update the static lease in place
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.private_network_attachment_update.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(managedResourceName, "ip_address", "10.0.0.200"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[managedResourceName].Primary.Attributes["mac_address"]; got != macAddress {
+							return fmt.Errorf("instance network interface has been re-created (MAC address %q, expected %q)", got, macAddress)
+						}
+						return nil
+					},
+				),
+			},
+			// Import
+			{
+				ResourceName:            managedResourceName,
+				ImportStateIdFunc:       importStateIDFunc(managedResourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
@@ -0,0 +1,536 @@
+package private_network_attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+const ResourcePrivateNetworkAttachmentDescription = `Attach an Exoscale [Compute Instance](https://community.exoscale.com/documentation/compute/) to a [Private Network](https://community.exoscale.com/documentation/compute/private-networks/).
+
+This resource allows managing private network attachments independently from the instance definition, e.g. to
+attach existing instances to a network managed by another team. The [exoscale_compute_instance](./compute_instance.md)
+resource ignores attachments declared outside of its ` + "`network_interface`" + ` blocks, which must not
+reference the same private network.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourcePrivateNetworkAttachment{}
+var _ resource.ResourceWithImportState = &ResourcePrivateNetworkAttachment{}
+
+// ResourcePrivateNetworkAttachment defines the resource implementation.
+type ResourcePrivateNetworkAttachment struct {
+	client *exoscale.Client
+}
+
+// NewResourcePrivateNetworkAttachment creates instance of ResourcePrivateNetworkAttachment.
+func NewResourcePrivateNetworkAttachment() resource.Resource {
+	return &ResourcePrivateNetworkAttachment{}
+}
+
+// ResourcePrivateNetworkAttachmentModel defines the resource data model.
+type ResourcePrivateNetworkAttachmentModel struct {
+	ID               types.String `tfsdk:"id"`
+	InstanceID       types.String `tfsdk:"instance_id"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	MACAddress       types.String `tfsdk:"mac_address"`
+	PrivateNetworkID types.String `tfsdk:"private_network_id"`
+	Zone             types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourcePrivateNetworkAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network_attachment"
+}
+
+// Schema defines resource attributes.
+func (r *ResourcePrivateNetworkAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourcePrivateNetworkAttachmentDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: "The attachment ID (`<private_network_id>/<instance_id>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrInstanceID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrInstanceIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrIPAddress: schema.StringAttribute{
+				MarkdownDescription: attrIPAddressDescription,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.IsIPv4AddressValidator{},
+				},
+			},
+			AttrMACAddress: schema.StringAttribute{
+				MarkdownDescription: attrMACAddressDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrPrivateNetworkID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrPrivateNetworkIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourcePrivateNetworkAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourcePrivateNetworkAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourcePrivateNetworkAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	privateNetworkID, instanceID, err := parseIDs(plan.PrivateNetworkID.ValueString(), plan.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	request := exoscale.AttachInstanceToPrivateNetworkRequest{
+		Instance: &exoscale.AttachInstanceToPrivateNetworkRequestInstance{ID: instanceID},
+	}
+	if !plan.IPAddress.IsUnknown() && !plan.IPAddress.IsNull() {
+		request.IP = net.ParseIP(plan.IPAddress.ValueString())
+	}
+
+	op, err := client.AttachInstanceToPrivateNetwork(ctx, privateNetworkID, request)
+	if err == nil {
+		_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to attach instance to private network",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", privateNetworkID, instanceID))
+
+	found, err := r.read(ctx, client, &plan)
+	if err == nil && !found {
+		err = errors.New("attachment not found after creation")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read private network attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourcePrivateNetworkAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourcePrivateNetworkAttachmentModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	found, err := r.read(ctx, client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read private network attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	if !found {
+		// Attachment doesn't exist anymore, signaling the core to remove it from the state.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourcePrivateNetworkAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourcePrivateNetworkAttachmentModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	// The static lease is changed in place, without detaching the instance.
+	if !plan.IPAddress.IsUnknown() && !plan.IPAddress.IsNull() && !plan.IPAddress.Equal(state.IPAddress) {
+		privateNetworkID, instanceID, err := parseIDs(state.PrivateNetworkID.ValueString(), state.InstanceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse attachment IDs",
+				err.Error(),
+			)
+			return
+		}
+
+		op, err := client.UpdatePrivateNetworkInstanceIP(ctx, privateNetworkID, exoscale.UpdatePrivateNetworkInstanceIPRequest{
+			Instance: &exoscale.UpdatePrivateNetworkInstanceIPRequestInstance{ID: instanceID},
+			IP:       net.ParseIP(plan.IPAddress.ValueString()),
+		})
+		if err == nil {
+			_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update private network instance IP address",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	found, err := r.read(ctx, client, &state)
+	if err == nil && !found {
+		err = errors.New("attachment not found after update")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read private network attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourcePrivateNetworkAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourcePrivateNetworkAttachmentModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	privateNetworkID, instanceID, err := parseIDs(state.PrivateNetworkID.ValueString(), state.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.DetachInstanceFromPrivateNetwork(ctx, privateNetworkID, exoscale.DetachInstanceFromPrivateNetworkRequest{
+		Instance: &exoscale.Instance{ID: instanceID},
+	})
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to detach instance from private network",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to detach instance from private network",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourcePrivateNetworkAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) == 2 {
+		idParts = append(strings.Split(idParts[0], "/"), idParts[1])
+	}
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: private_network_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourcePrivateNetworkAttachmentModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", idParts[0], idParts[1]))
+	state.PrivateNetworkID = types.StringValue(idParts[0])
+	state.InstanceID = types.StringValue(idParts[1])
+	state.Zone = types.StringValue(idParts[2])
+	state.IPAddress = types.StringNull()
+	state.MACAddress = types.StringNull()
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// read updates the model from the attachment current state, and reports
+// whether the instance is still attached to the private network.
+func (r *ResourcePrivateNetworkAttachment) read(
+	ctx context.Context,
+	client *exoscale.Client,
+	m *ResourcePrivateNetworkAttachmentModel,
+) (bool, error) {
+	privateNetworkID, instanceID, err := parseIDs(m.PrivateNetworkID.ValueString(), m.InstanceID.ValueString())
+	if err != nil {
+		return false, err
+	}
+
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var attachment *exoscale.InstancePrivateNetworks
+	for i, privnet := range instance.PrivateNetworks {
+		if privnet.ID == privateNetworkID {
+			attachment = &instance.PrivateNetworks[i]
+			break
+		}
+	}
+	if attachment == nil {
+		return false, nil
+	}
+
+	m.MACAddress = types.StringValue(attachment.MACAddress)
+
+	privateNetwork, err := client.GetPrivateNetwork(ctx, privateNetworkID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Only managed private networks hand out leases.
+	m.IPAddress = types.StringNull()
+	for _, lease := range privateNetwork.Leases {
+		if lease.InstanceID == instanceID {
+			m.IPAddress = types.StringValue(lease.IP.String())
+			break
+		}
+	}
+
+	return true, nil
+}
+
+func parseIDs(privateNetworkID, instanceID string) (exoscale.UUID, exoscale.UUID, error) {
+	pnID, err := exoscale.ParseUUID(privateNetworkID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid private network ID: %w", err)
+	}
+
+	iID, err := exoscale.ParseUUID(instanceID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	return pnID, iID, nil
+}
@@ -0,0 +1,15 @@
+package reverse_dns
+
+const (
+	Name = "exoscale_reverse_dns"
+
+	AttrDomainName             = "domain_name"
+	attrDomainNameDescription  = "The domain name to set as reverse DNS (PTR) record, without trailing dot (e.g. `www.example.net`)."
+	AttrElasticIPID            = "elastic_ip_id"
+	attrElasticIPIDDescription = "The [exoscale_elastic_ip](./elastic_ip.md) (ID) to manage the reverse DNS record of (conflicts with `instance_id`)."
+	AttrID                     = "id"
+	AttrInstanceID             = "instance_id"
+	attrInstanceIDDescription  = "The [exoscale_compute_instance](./compute_instance.md) (ID) to manage the reverse DNS record of (conflicts with `elastic_ip_id`)."
+	AttrZone                   = "zone"
+	attrZoneDescription        = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
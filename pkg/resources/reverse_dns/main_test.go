@@ -0,0 +1,97 @@
+package reverse_dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestReverseDNS(t *testing.T) {
+	instanceResourceName := "exoscale_reverse_dns.test_instance"
+	elasticIPResourceName := "exoscale_reverse_dns.test_elastic_ip"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	importStateIDFunc := func(r string) resource.ImportStateIdFunc {
+		return func(s *terraform.State) (string, error) {
+			return fmt.Sprintf("%s@%s", s.RootModule().Resources[r].Primary.ID, testdataSpec.Zone), nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1 Create records
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.reverse_dns_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						instanceResourceName,
+						"domain_name",
+						fmt.Sprintf("terraform-provider-test-%d.exoscale.com", testdataSpec.ID),
+					),
+					resource.TestCheckResourceAttrPair(
+						instanceResourceName, "id",
+						"exoscale_compute_instance.test_instance", "id",
+					),
+					resource.TestCheckResourceAttr(
+						elasticIPResourceName,
+						"domain_name",
+						fmt.Sprintf("terraform-provider-test-eip-%d.exoscale.com", testdataSpec.ID),
+					),
+					resource.TestCheckResourceAttrPair(
+						elasticIPResourceName, "id",
+						"exoscale_elastic_ip.test_elastic_ip", "id",
+					),
+				),
+			},
+			// 2 Update records
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.reverse_dns_update.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						instanceResourceName,
+						"domain_name",
+						fmt.Sprintf("terraform-provider-test-%d-updated.exoscale.com", testdataSpec.ID),
+					),
+					resource.TestCheckResourceAttr(
+						elasticIPResourceName,
+						"domain_name",
+						fmt.Sprintf("terraform-provider-test-eip-%d-updated.exoscale.com", testdataSpec.ID),
+					),
+				),
+			},
+			// 3 Domain names with a trailing dot are rejected
+			{
+				Config:      testutils.ParseTestdataConfig("./testdata/003.reverse_dns_trailing_dot.tf.tmpl", &testdataSpec),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must not end with a dot"),
+			},
+			// Import
+			{
+				ResourceName:            instanceResourceName,
+				ImportStateIdFunc:       importStateIDFunc(instanceResourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				ResourceName:            elasticIPResourceName,
+				ImportStateIdFunc:       importStateIDFunc(elasticIPResourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
@@ -0,0 +1,488 @@
+package reverse_dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceReverseDNSDescription = `Manage the reverse DNS (PTR) record of an Exoscale [Compute Instance](https://community.exoscale.com/documentation/compute/) or [Elastic IP](https://community.exoscale.com/documentation/compute/eip/).
+
+This resource allows managing reverse DNS records independently from their target resource.
+It must not be used together with the ` + "`reverse_dns`" + ` attribute of the target resource, which should
+be excluded from change detection with ` + "`lifecycle { ignore_changes = [reverse_dns] }`" + `.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceReverseDNS{}
+var _ resource.ResourceWithImportState = &ResourceReverseDNS{}
+
+// ResourceReverseDNS defines the resource implementation.
+type ResourceReverseDNS struct {
+	client *exoscale.Client
+}
+
+// NewResourceReverseDNS creates instance of ResourceReverseDNS.
+func NewResourceReverseDNS() resource.Resource {
+	return &ResourceReverseDNS{}
+}
+
+// ResourceReverseDNSModel defines the resource data model.
+type ResourceReverseDNSModel struct {
+	ID          types.String `tfsdk:"id"`
+	DomainName  types.String `tfsdk:"domain_name"`
+	ElasticIPID types.String `tfsdk:"elastic_ip_id"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Zone        types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceReverseDNS) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_dns"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceReverseDNS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceReverseDNSDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: "The ID of the target resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrDomainName: schema.StringAttribute{
+				MarkdownDescription: attrDomainNameDescription,
+				Required:            true,
+				Validators: []validator.String{
+					// The API returns the domain name without trailing dot, which would otherwise show as a diff.
+					stringvalidator.RegexMatches(regexp.MustCompile(`[^.]$`), "must not end with a dot"),
+				},
+			},
+			AttrElasticIPID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrElasticIPIDDescription,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(AttrInstanceID)),
+				},
+			},
+			AttrInstanceID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrInstanceIDDescription,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceReverseDNS) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceReverseDNS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceReverseDNSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.InstanceID
+	if plan.ID.IsNull() {
+		plan.ID = plan.ElasticIPID
+	}
+
+	if err := r.update(ctx, client, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create reverse DNS record",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceReverseDNS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceReverseDNSModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse target ID",
+			err.Error(),
+		)
+		return
+	}
+
+	var record *exoscale.ReverseDNSRecord
+	if !state.InstanceID.IsNull() {
+		record, err = client.GetReverseDNSInstance(ctx, id)
+	} else {
+		record, err = client.GetReverseDNSElasticIP(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			// Record (or its target) doesn't exist anymore, signaling the core to remove it from the state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to get reverse DNS record",
+			err.Error(),
+		)
+		return
+	}
+
+	if record == nil || record.DomainName == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DomainName = types.StringValue(strings.TrimSuffix(string(record.DomainName), "."))
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceReverseDNS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceReverseDNSModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.DomainName.Equal(state.DomainName) {
+		if err := r.update(ctx, client, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update reverse DNS record",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state.DomainName = plan.DomainName
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceReverseDNS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceReverseDNSModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := exoscale.ParseUUID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse target ID",
+			err.Error(),
+		)
+		return
+	}
+
+	var op *exoscale.Operation
+	if !state.InstanceID.IsNull() {
+		op, err = client.DeleteReverseDNSInstance(ctx, id)
+	} else {
+		op, err = client.DeleteReverseDNSElasticIP(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to delete reverse DNS record",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to delete reverse DNS record",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+// As the import identifier doesn't tell whether the target is an instance or an
+// Elastic IP, the ID is looked up as an instance first, then as an Elastic IP.
+func (r *ResourceReverseDNS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceReverseDNSModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	id, err := exoscale.ParseUUID(idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse target ID",
+			err.Error(),
+		)
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(idParts[1]),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+	state.InstanceID = types.StringNull()
+	state.ElasticIPID = types.StringNull()
+
+	if _, err := client.GetInstance(ctx, id); err == nil {
+		state.InstanceID = state.ID
+	} else if !errors.Is(err, exoscale.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"unable to get instance",
+			err.Error(),
+		)
+		return
+	} else if _, err := client.GetElasticIP(ctx, id); err == nil {
+		state.ElasticIPID = state.ID
+	} else {
+		resp.Diagnostics.AddError(
+			"unable to find reverse DNS target",
+			fmt.Sprintf("no instance nor Elastic IP found with ID %q: %s", idParts[0], err),
+		)
+		return
+	}
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// update creates or updates the reverse DNS record of the target described by the model.
+func (r *ResourceReverseDNS) update(ctx context.Context, client *exoscale.Client, m *ResourceReverseDNSModel) error {
+	id, err := exoscale.ParseUUID(m.ID.ValueString())
+	if err != nil {
+		return fmt.Errorf("unable to parse target ID: %w", err)
+	}
+
+	var op *exoscale.Operation
+	if !m.InstanceID.IsNull() {
+		op, err = client.UpdateReverseDNSInstance(ctx, id, exoscale.UpdateReverseDNSInstanceRequest{
+			DomainName: m.DomainName.ValueString(),
+		})
+	} else {
+		op, err = client.UpdateReverseDNSElasticIP(ctx, id, exoscale.UpdateReverseDNSElasticIPRequest{
+			DomainName: m.DomainName.ValueString(),
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+
+	return err
+}
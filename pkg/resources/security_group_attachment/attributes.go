@@ -0,0 +1,13 @@
+package security_group_attachment
+
+const (
+	Name = "exoscale_security_group_attachment"
+
+	AttrID                         = "id"
+	AttrInstanceID                 = "instance_id"
+	attrInstanceIDDescription      = "The [exoscale_compute_instance](./compute_instance.md) (ID) to attach to the security group."
+	AttrSecurityGroupID            = "security_group_id"
+	attrSecurityGroupIDDescription = "The [exoscale_security_group](./security_group.md) (ID) to attach the instance to."
+	AttrZone                       = "zone"
+	attrZoneDescription            = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+)
@@ -0,0 +1,49 @@
+package security_group_attachment_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func TestSecurityGroupAttachment(t *testing.T) {
+	resourceName := "exoscale_security_group_attachment.test_baseline"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// 1 Create attachment (the instance must not plan to detach it)
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.security_group_attachment_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "security_group_id",
+						"exoscale_security_group.test_baseline", "id",
+					),
+					resource.TestCheckResourceAttr("exoscale_compute_instance.test_instance", "security_group_ids.#", "1"),
+				),
+			},
+			// Import
+			{
+				ResourceName: resourceName,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", s.RootModule().Resources[resourceName].Primary.ID, testdataSpec.Zone), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
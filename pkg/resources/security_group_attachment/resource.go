@@ -0,0 +1,402 @@
+package security_group_attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const ResourceSecurityGroupAttachmentDescription = `Attach an Exoscale [Compute Instance](https://community.exoscale.com/documentation/compute/) to a [Security Group](https://community.exoscale.com/documentation/compute/security-groups/).
+
+This resource allows managing security group attachments independently from the instance definition, e.g. to
+attach baseline security groups to instances defined elsewhere. The [exoscale_compute_instance](./compute_instance.md)
+resource ignores attachments not listed in its ` + "`security_group_ids`" + ` attribute, which must not
+reference the same security group.
+`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceSecurityGroupAttachment{}
+var _ resource.ResourceWithImportState = &ResourceSecurityGroupAttachment{}
+
+// ResourceSecurityGroupAttachment defines the resource implementation.
+type ResourceSecurityGroupAttachment struct {
+	client *exoscale.Client
+}
+
+// NewResourceSecurityGroupAttachment creates instance of ResourceSecurityGroupAttachment.
+func NewResourceSecurityGroupAttachment() resource.Resource {
+	return &ResourceSecurityGroupAttachment{}
+}
+
+// ResourceSecurityGroupAttachmentModel defines the resource data model.
+type ResourceSecurityGroupAttachmentModel struct {
+	ID              types.String `tfsdk:"id"`
+	InstanceID      types.String `tfsdk:"instance_id"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+	Zone            types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceSecurityGroupAttachment) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_attachment"
+}
+
+// Schema defines resource attributes.
+func (r *ResourceSecurityGroupAttachment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceSecurityGroupAttachmentDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: "The attachment ID (`<security_group_id>/<instance_id>`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrInstanceID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrInstanceIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrSecurityGroupID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrSecurityGroupIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceSecurityGroupAttachment) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceSecurityGroupAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceSecurityGroupAttachmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	securityGroupID, instanceID, err := parseIDs(plan.SecurityGroupID.ValueString(), plan.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.AttachInstanceToSecurityGroup(ctx, securityGroupID, exoscale.AttachInstanceToSecurityGroupRequest{
+		Instance: &exoscale.Instance{ID: instanceID},
+	})
+	if err == nil {
+		_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to attach instance to security group",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", securityGroupID, instanceID))
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceSecurityGroupAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceSecurityGroupAttachmentModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	securityGroupID, instanceID, err := parseIDs(state.SecurityGroupID.ValueString(), state.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			// Instance doesn't exist anymore, signaling the core to remove the attachment from the state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to get instance",
+			err.Error(),
+		)
+		return
+	}
+
+	attached := false
+	for _, sg := range instance.SecurityGroups {
+		if sg.ID == securityGroupID {
+			attached = true
+			break
+		}
+	}
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+// All attributes but timeouts require replacement.
+func (r *ResourceSecurityGroupAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceSecurityGroupAttachmentModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceSecurityGroupAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceSecurityGroupAttachmentModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	securityGroupID, instanceID, err := parseIDs(state.SecurityGroupID.ValueString(), state.InstanceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse attachment IDs",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err := client.DetachInstanceFromSecurityGroup(ctx, securityGroupID, exoscale.DetachInstanceFromSecurityGroupRequest{
+		Instance: &exoscale.Instance{ID: instanceID},
+	})
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to detach instance from security group",
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to detach instance from security group",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceSecurityGroupAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	if len(idParts) == 2 {
+		idParts = append(strings.Split(idParts[0], "/"), idParts[1])
+	}
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: security_group_id/instance_id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceSecurityGroupAttachmentModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", idParts[0], idParts[1]))
+	state.SecurityGroupID = types.StringValue(idParts[0])
+	state.InstanceID = types.StringValue(idParts[1])
+	state.Zone = types.StringValue(idParts[2])
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+func parseIDs(securityGroupID, instanceID string) (exoscale.UUID, exoscale.UUID, error) {
+	sgID, err := exoscale.ParseUUID(securityGroupID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid security group ID: %w", err)
+	}
+
+	iID, err := exoscale.ParseUUID(instanceID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid instance ID: %w", err)
+	}
+
+	return sgID, iID, nil
+}
@@ -0,0 +1,189 @@
+package sks
+
+const (
+	AuthorityCertName       = "exoscale_sks_cluster_authority_cert"
+	ClusterName             = "exoscale_sks_cluster"
+	DeprecatedResourcesName = "exoscale_sks_cluster_deprecated_resources"
+	InspectionName          = "exoscale_sks_cluster_inspection"
+	KubeconfigName          = "exoscale_sks_kubeconfig"
+	NodepoolName            = "exoscale_sks_nodepool"
+	VersionsName            = "exoscale_sks_versions"
+
+	AttrClusterID                                   = "cluster_id"
+	attrClusterIDDescription                        = "The [exoscale_sks_cluster](../resources/sks_cluster.md) (ID)."
+	AttrDeprecatedResources                         = "resources"
+	attrDeprecatedResourcesDescription              = "The deprecated resources in use."
+	AttrDeprecatedResourceGroup                     = "group"
+	attrDeprecatedResourceGroupDescription          = "The API group of the resource (empty for the core group)."
+	AttrDeprecatedResourceProperties                = "properties"
+	attrDeprecatedResourcePropertiesDescription     = "All the properties reported by the API for the resource."
+	AttrDeprecatedResourceRemovedRelease            = "removed_release"
+	attrDeprecatedResourceRemovedReleaseDescription = "The Kubernetes release removing the API version of the resource (e.g. `1.25`)."
+	AttrDeprecatedResourceResource                  = "resource"
+	attrDeprecatedResourceResourceDescription       = "The resource name (e.g. `podsecuritypolicies`)."
+	AttrDeprecatedResourceSubresource               = "subresource"
+	attrDeprecatedResourceSubresourceDescription    = "The subresource name, if any."
+	AttrDeprecatedResourceVersion                   = "version"
+	attrDeprecatedResourceVersionDescription        = "The API version of the resource (e.g. `v1beta1`)."
+	AttrID                                          = "id"
+	attrIDDescription                               = "The data source identifier (the cluster ID)."
+	AttrTargetVersion                               = "target_version"
+	attrTargetVersionDescription                    = "Only return the resources which are no longer served by this Kubernetes version (e.g. `1.30.2`; default: all deprecated resources are returned)."
+	AttrZone                                        = "zone"
+	attrZoneDescription                             = "The Exoscale [Zone](https://www.exoscale.com/datacenters/) name."
+
+	AttrIncludeDeprecated            = "include_deprecated"
+	attrIncludeDeprecatedDescription = "Also return the deprecated versions (default: `false`)."
+	AttrLatestVersion                = "latest_version"
+	attrLatestVersionDescription     = "The most recent version (of the `minor` release, if set)."
+	AttrMinor                        = "minor"
+	attrMinorDescription             = "Only return the versions of this minor release (e.g. `1.30`)."
+	AttrVersions                     = "versions"
+	attrVersionsDescription          = "The available versions, from the most recent to the oldest."
+	attrVersionsIDDescription        = "The data source identifier."
+
+	AttrInspectionJSON              = "json"
+	attrInspectionJSONDescription   = "The raw inspection report, as returned by the API (JSON-encoded)."
+	AttrInspectionReport            = "report"
+	attrInspectionReportDescription = "The inspection report, as a structured value whose attributes may be accessed directly (e.g. in `check` blocks)."
+
+	AttrAuthority                  = "authority"
+	attrAuthorityDescription       = "The certificate authority (`aggregation`, `control-plane` or `kubelet`)."
+	AttrCertificate                = "certificate"
+	attrCertificateDescription     = "The CA certificate (in PEM format)."
+	AttrExpiresAt                  = "expires_at"
+	attrExpiresAtDescription       = "The CA certificate expiration date (RFC 3339 format)."
+	attrAuthorityCertIDDescription = "The data source identifier (`<cluster ID>/<authority>`)."
+
+	AttrClientCertificate               = "client_certificate"
+	attrClientCertificateDescription    = "The client certificate of the Kubeconfig (in PEM format)."
+	AttrClientKey                       = "client_key"
+	attrClientKeyDescription            = "The client private key of the Kubeconfig (in PEM format)."
+	AttrClusterCACertificate            = "cluster_ca_certificate"
+	attrClusterCACertificateDescription = "The cluster CA certificate of the Kubeconfig (in PEM format)."
+	AttrEarlyRenewalSeconds             = "early_renewal_seconds"
+	attrEarlyRenewalSecondsDescription  = "If set, the resource will consider the Kubeconfig to have expired the given number of seconds before its actual CA certificate or client certificate expiry time. This can be useful to deploy an updated Kubeconfig in advance of the expiration of its internal current certificate. Note however that the old certificate remains valid until its true expiration time since this resource does not (and cannot) support revocation. Also note this advance update can only take place if the Terraform configuration is applied during the early renewal period (seconds; default: 0)."
+	AttrGroups                          = "groups"
+	attrGroupsDescription               = "Group names in the generated Kubeconfig. The certificate present in the Kubeconfig will have these roles set in the Organization field."
+	AttrHost                            = "host"
+	attrHostDescription                 = "The Kubernetes API server URL of the Kubeconfig."
+	AttrKubeconfig                      = "kubeconfig"
+	attrKubeconfigDescription           = "The generated Kubeconfig (YAML content)."
+	attrKubeconfigExpiresAtDescription  = "The expiration date of the Kubeconfig, i.e. the earliest expiration date of its certificates (RFC 3339 format)."
+	attrKubeconfigIDDescription         = "The Kubeconfig identifier (the serial numbers of its certificates)."
+	AttrReadyForRenewal                 = "ready_for_renewal"
+	attrReadyForRenewalDescription      = "Whether the Kubeconfig is due for renewal (see `early_renewal_seconds`)."
+	AttrTTLSeconds                      = "ttl_seconds"
+	attrTTLSecondsDescription           = "The Time-to-Live of the Kubeconfig, after which it will expire / become invalid (seconds; default: 2592000 = 30 days)."
+	AttrUser                            = "user"
+	attrUserDescription                 = "User name in the generated Kubeconfig. The certificate present in the Kubeconfig will also have this name set for the CN field."
+
+	AttrCreatedAt         = "created_at"
+	AttrDescription       = "description"
+	AttrLabels            = "labels"
+	attrLabelsDescription = "A map of key/value labels."
+	AttrName              = "name"
+	AttrState             = "state"
+	AttrVersion           = "version"
+
+	AttrClusterAddons                        = "addons"
+	attrClusterAddonsDeprecation             = "This attribute has been replaced by `exoscale_ccm`/`metrics_server` attributes, it will be removed in a future release."
+	AttrClusterAggregationCA                 = "aggregation_ca"
+	attrClusterAggregationCADescription      = "The CA certificate (in PEM format) for TLS communications between the control plane and the aggregation layer (e.g. `metrics-server`)."
+	AttrClusterAutoUpgrade                   = "auto_upgrade"
+	attrClusterAutoUpgradeDescription        = "Enable automatic upgrading of the control plane version."
+	AttrClusterCNI                           = "cni"
+	attrClusterCNIDescription                = "The CNI plugin that is to be used. Available options are \"calico\" or \"cilium\". Defaults to \"calico\". Setting empty string will result in a cluster with no CNI."
+	AttrClusterControlPlaneCA                = "control_plane_ca"
+	attrClusterControlPlaneCADescription     = "The CA certificate (in PEM format) for TLS communications between control plane components."
+	attrClusterCreatedAtDescription          = "The cluster creation date."
+	attrClusterDescriptionDescription        = "A free-form text describing the cluster."
+	AttrClusterEndpoint                      = "endpoint"
+	attrClusterEndpointDescription           = "The cluster API endpoint."
+	AttrClusterExoscaleCCM                   = "exoscale_ccm"
+	attrClusterExoscaleCCMDescription        = "Deploy the Exoscale [Cloud Controller Manager](https://github.com/exoscale/exoscale-cloud-controller-manager/) in the control plane (boolean; default: `true`)."
+	AttrClusterExoscaleCSI                   = "exoscale_csi"
+	attrClusterExoscaleCSIDescription        = "Deploy the Exoscale [Container Storage Interface](https://github.com/exoscale/exoscale-csi-driver/) on worker nodes (boolean; default: `false`; requires the CCM to be enabled)."
+	AttrClusterFailUpgrade                   = "fail_upgrade_on_deprecated_resources"
+	attrClusterFailUpgradeDescription        = "Fail the plan (and the apply) of a `version` change if the cluster uses resources which are no longer served by the target Kubernetes version (see the [exoscale_sks_cluster_deprecated_resources](../data-sources/sks_cluster_deprecated_resources.md) data source)."
+	attrClusterIDDescriptionResource         = "The SKS cluster ID."
+	AttrClusterKubeletCA                     = "kubelet_ca"
+	attrClusterKubeletCADescription          = "The CA certificate (in PEM format) for TLS communications between kubelets and the control plane."
+	AttrClusterMetricsServer                 = "metrics_server"
+	attrClusterMetricsServerDescription      = "Deploy the [Kubernetes Metrics Server](https://github.com/kubernetes-sigs/metrics-server/) in the control plane (boolean; default: `true`)."
+	attrClusterNameDescription               = "The SKS cluster name."
+	AttrClusterNodepools                     = "nodepools"
+	attrClusterNodepoolsDescription          = "The list of [exoscale_sks_nodepool](./sks_nodepool.md) (IDs) attached to the cluster."
+	AttrClusterOIDC                          = "oidc"
+	attrClusterOIDCDescription               = "An OpenID Connect configuration to provide to the Kubernetes API server."
+	AttrClusterOIDCClientID                  = "client_id"
+	attrClusterOIDCClientIDDescription       = "The OpenID client ID."
+	AttrClusterOIDCGroupsClaim               = "groups_claim"
+	attrClusterOIDCGroupsClaimDescription    = "An OpenID JWT claim to use as the user's group."
+	AttrClusterOIDCGroupsPrefix              = "groups_prefix"
+	attrClusterOIDCGroupsPrefixDescription   = "An OpenID prefix prepended to group claims."
+	AttrClusterOIDCIssuerURL                 = "issuer_url"
+	attrClusterOIDCIssuerURLDescription      = "The OpenID provider URL."
+	AttrClusterOIDCRequiredClaim             = "required_claim"
+	attrClusterOIDCRequiredClaimDescription  = "A map of key/value pairs that describes a required claim in the OpenID Token."
+	AttrClusterOIDCUsernameClaim             = "username_claim"
+	attrClusterOIDCUsernameClaimDescription  = "An OpenID JWT claim to use as the user name."
+	AttrClusterOIDCUsernamePrefix            = "username_prefix"
+	attrClusterOIDCUsernamePrefixDescription = "An OpenID prefix prepended to username claims."
+	AttrClusterRotateCCMCreds                = "rotate_ccm_credentials_trigger"
+	attrClusterRotateCCMCredsDescription     = "An arbitrary value which, when changed, triggers the rotation of the Exoscale Cloud Controller Manager (CCM) credentials."
+	AttrClusterRotateOperatorsCA             = "rotate_operators_ca_trigger"
+	attrClusterRotateOperatorsCADescription  = "An arbitrary value which, when changed, triggers the rotation of the operators CA certificate (exposed as `control_plane_ca`)."
+	AttrClusterServiceLevel                  = "service_level"
+	attrClusterServiceLevelDescription       = "The service level of the control plane (`pro` or `starter`; default: `pro`). A `starter` cluster can be upgraded to `pro` in place, but a `pro` cluster can't be downgraded to `starter`."
+	attrClusterStateDescription              = "The cluster state."
+	attrClusterVersionDescription            = "The version of the control plane (default: latest version available from the API; see the [exoscale_sks_versions](../data-sources/sks_versions.md) data source for reference). Changing it upgrades the control plane in place."
+
+	AttrNodepoolAntiAffinityGroupIDs                      = "anti_affinity_group_ids"
+	attrNodepoolAntiAffinityGroupIDsDescription           = "A list of [exoscale_anti_affinity_group](./anti_affinity_group.md) (IDs) to be attached to the managed instances."
+	attrNodepoolClusterIDDescription                      = "The parent [exoscale_sks_cluster](./sks_cluster.md) ID."
+	attrNodepoolCreatedAtDescription                      = "The pool creation date."
+	AttrNodepoolDeployTargetID                            = "deploy_target_id"
+	attrNodepoolDeployTargetIDDescription                 = "A deploy target ID (see the [exoscale_deploy_target](../data-sources/deploy_target.md) data source)."
+	attrNodepoolDescriptionDescription                    = "A free-form text describing the pool."
+	AttrNodepoolDiskSize                                  = "disk_size"
+	attrNodepoolDiskSizeDescription                       = "The managed instances disk size (GiB; default: `50`)."
+	attrNodepoolIDDescription                             = "The SKS node pool ID."
+	AttrNodepoolInstancePoolID                            = "instance_pool_id"
+	attrNodepoolInstancePoolIDDescription                 = "The underlying [exoscale_instance_pool](./instance_pool.md) ID."
+	AttrNodepoolInstancePrefix                            = "instance_prefix"
+	attrNodepoolInstancePrefixDescription                 = "The string used to prefix the managed instances name (default `pool`)."
+	AttrNodepoolInstanceType                              = "instance_type"
+	attrNodepoolInstanceTypeDescription                   = "The managed compute instances type (`<family>.<size>`, e.g. `standard.medium`; use the [exoscale_compute_instance_type_list](../data-sources/compute_instance_type_list.md) data source for the list of available types, validated at plan time)."
+	AttrNodepoolKubeletImageGC                            = "kubelet_image_gc"
+	attrNodepoolKubeletImageGCDescription                 = "Configuration for this nodepool's kubelet image garbage collector."
+	AttrNodepoolKubeletImageGCHighThreshold               = "high_threshold"
+	attrNodepoolKubeletImageGCHighThresholdDescription    = "The percent of disk usage after which image garbage collection is always run"
+	AttrNodepoolKubeletImageGCLowThreshold                = "low_threshold"
+	attrNodepoolKubeletImageGCLowThresholdDescription     = "The percent of disk usage before which image garbage collection is never run"
+	AttrNodepoolKubeletImageGCMinAge                      = "min_age"
+	attrNodepoolKubeletImageGCMinAgeDescription           = "The minimum age for an unused image before it is garbage collected (k8s duration format, eg. 1h)"
+	attrNodepoolNameDescription                           = "The SKS node pool name."
+	AttrNodepoolNodeUpgrade                               = "node_upgrade"
+	attrNodepoolNodeUpgradeDescription                    = "Cycle the existing nodes in batches once the parent cluster version has been upgraded, so that they run the new Kubernetes version (by default, only new nodes do)."
+	AttrNodepoolNodeUpgradeBatchSize                      = "batch_size"
+	attrNodepoolNodeUpgradeBatchSizeDescription           = "The number of nodes to evict at once (default: `1`)."
+	AttrNodepoolNodeUpgradeClusterVersion                 = "cluster_version"
+	attrNodepoolNodeUpgradeClusterVersionDescription      = "The parent cluster version (i.e. `exoscale_sks_cluster.<name>.version`): setting it cycles the nodes in the same apply as the control plane upgrade, instead of the following one."
+	AttrNodepoolNodeUpgradePauseBetweenBatches            = "pause_between_batches"
+	attrNodepoolNodeUpgradePauseBetweenBatchesDescription = "A duration to wait for between two batches, once the replacement nodes are running (e.g. `30s`, `5m`)."
+	AttrNodepoolPrivateNetworkIDs                         = "private_network_ids"
+	attrNodepoolPrivateNetworkIDsDescription              = "A list of [exoscale_private_network](./private_network.md) (IDs) to be attached to the managed instances."
+	AttrNodepoolSecurityGroupIDs                          = "security_group_ids"
+	attrNodepoolSecurityGroupIDsDescription               = "A list of [exoscale_security_group](./security_group.md) (IDs) to be attached to the managed instances."
+	AttrNodepoolSize                                      = "size"
+	attrNodepoolSizeDescription                           = "The number of managed instances."
+	attrNodepoolStateDescription                          = "The current pool state."
+	AttrNodepoolStorageLVM                                = "storage_lvm"
+	attrNodepoolStorageLVMDescription                     = "Create nodes with non-standard partitioning for persistent storage (requires min 100G of disk space)."
+	AttrNodepoolTaints                                    = "taints"
+	attrNodepoolTaintsDescription                         = "A map of key/value Kubernetes [taints](https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/) ('taints = { <key> = \"<value>:<effect>\" }')."
+	AttrNodepoolTemplateID                                = "template_id"
+	attrNodepoolTemplateIDDescription                     = "The managed instances template ID."
+	attrNodepoolVersionDescription                        = "The managed instances version."
+)
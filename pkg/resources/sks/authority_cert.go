@@ -0,0 +1,30 @@
+package sks
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"time"
+)
+
+// parseAuthorityCert decodes a base64-encoded PEM CA certificate as returned by the API,
+// and returns it along with its expiration date.
+func parseAuthorityCert(encoded string) (string, time.Time, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	block, _ := pem.Decode(decoded)
+	if block == nil {
+		return "", time.Time{}, errors.New("no PEM data found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return string(decoded), cert.NotAfter.UTC(), nil
+}
@@ -0,0 +1,156 @@
+package sks
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"sort"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	defaultClusterCNI          = "calico"
+	defaultClusterServiceLevel = "pro"
+
+	clusterAddonExoscaleCCM   = "exoscale-cloud-controller"
+	clusterAddonExoscaleCSI   = "exoscale-container-storage-interface"
+	clusterAddonMetricsServer = "metrics-server"
+)
+
+// clusterUpdatePollInterval is the interval between two checks of the cluster state during an update.
+var clusterUpdatePollInterval = 3 * time.Second
+
+// clusterAddonToggles lists the cluster attributes toggling an addon.
+var clusterAddonToggles = []struct {
+	Attr  string
+	Addon string
+}{
+	{AttrClusterExoscaleCCM, clusterAddonExoscaleCCM},
+	{AttrClusterExoscaleCSI, clusterAddonExoscaleCSI},
+	{AttrClusterMetricsServer, clusterAddonMetricsServer},
+}
+
+// clusterAddons returns the (sorted) addons resulting from enabling or disabling
+// the addons of toggles (addon name -> enabled) on top of addons.
+func clusterAddons(addons []string, toggles map[string]bool) []string {
+	result := make([]string, 0, len(addons)+len(toggles))
+	for _, addon := range addons {
+		if enabled, ok := toggles[addon]; ok && !enabled {
+			continue
+		}
+		if !slices.Contains(result, addon) {
+			result = append(result, addon)
+		}
+	}
+
+	for addon, enabled := range toggles {
+		if enabled && !slices.Contains(result, addon) {
+			result = append(result, addon)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// clusterCertificates holds the CA certificates (in PEM format) of an SKS cluster.
+type clusterCertificates struct {
+	AggregationCA  string
+	ControlPlaneCA string
+	KubeletCA      string
+}
+
+// readClusterCertificates returns the CA certificates of an SKS cluster.
+func readClusterCertificates(ctx context.Context, client *exoscale.Client, clusterID exoscale.UUID) (*clusterCertificates, error) {
+	certificates := make(map[exoscale.GetSKSClusterAuthorityCertAuthority]string, 3)
+
+	for _, authority := range []exoscale.GetSKSClusterAuthorityCertAuthority{
+		exoscale.GetSKSClusterAuthorityCertAuthorityAggregation,
+		exoscale.GetSKSClusterAuthorityCertAuthorityControlPlane,
+		exoscale.GetSKSClusterAuthorityCertAuthorityKubelet,
+	} {
+		res, err := client.GetSKSClusterAuthorityCert(ctx, clusterID, authority)
+		if err != nil {
+			return nil, err
+		}
+
+		certificate, err := base64.StdEncoding.DecodeString(res.Cacert)
+		if err != nil {
+			return nil, err
+		}
+
+		certificates[authority] = string(certificate)
+	}
+
+	return &clusterCertificates{
+		AggregationCA:  certificates[exoscale.GetSKSClusterAuthorityCertAuthorityAggregation],
+		ControlPlaneCA: certificates[exoscale.GetSKSClusterAuthorityCertAuthorityControlPlane],
+		KubeletCA:      certificates[exoscale.GetSKSClusterAuthorityCertAuthorityKubelet],
+	}, nil
+}
+
+// await returns a function waiting for the successful completion of the operation
+// returned by an API call.
+func await(ctx context.Context, client *exoscale.Client) func(op *exoscale.Operation, err error) error {
+	return func(op *exoscale.Operation, err error) error {
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+
+		return err
+	}
+}
+
+// updateCluster applies an update request to a cluster and waits for its completion.
+func updateCluster(ctx context.Context, client *exoscale.Client, clusterID exoscale.UUID, req exoscale.UpdateSKSClusterRequest) error {
+	// due to a bug it's possible for the update operation
+	// to remain in pending state forever
+	// we work around this by checking the cluster state
+	updateErrChan := make(chan error, 1)
+	getErrChan := make(chan error, 1)
+
+	go func() {
+		updateErrChan <- await(ctx, client)(client.UpdateSKSCluster(ctx, clusterID, req))
+	}()
+
+	go func() {
+		getErrChan <- waitForClusterUpdate(ctx, client, clusterID)
+	}()
+
+	select {
+	case err := <-updateErrChan:
+		return err
+	case err := <-getErrChan:
+		return err
+	}
+}
+
+// waitForClusterUpdate waits for the cluster to enter, then leave, the "updating" state.
+func waitForClusterUpdate(ctx context.Context, client *exoscale.Client, clusterID exoscale.UUID) error {
+	ticker := time.NewTicker(clusterUpdatePollInterval)
+	defer ticker.Stop()
+
+	hasStartedUpdate := false
+	for {
+		select {
+		case <-ticker.C:
+			cluster, err := client.GetSKSCluster(ctx, clusterID)
+			if err != nil {
+				return err
+			}
+
+			if hasStartedUpdate && cluster.State != exoscale.SKSClusterStateUpdating {
+				return nil
+			} else if cluster.State == exoscale.SKSClusterStateUpdating {
+				hasStartedUpdate = true
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
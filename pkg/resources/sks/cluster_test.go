@@ -0,0 +1,60 @@
+package sks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterAddons(t *testing.T) {
+	tests := []struct {
+		name    string
+		addons  []string
+		toggles map[string]bool
+		want    []string
+	}{
+		{
+			name: "defaults",
+			toggles: map[string]bool{
+				clusterAddonExoscaleCCM:   true,
+				clusterAddonExoscaleCSI:   false,
+				clusterAddonMetricsServer: true,
+			},
+			want: []string{clusterAddonExoscaleCCM, clusterAddonMetricsServer},
+		},
+		{
+			name:    "enable",
+			addons:  []string{clusterAddonExoscaleCCM},
+			toggles: map[string]bool{clusterAddonExoscaleCSI: true},
+			want:    []string{clusterAddonExoscaleCCM, clusterAddonExoscaleCSI},
+		},
+		{
+			name:    "disable",
+			addons:  []string{clusterAddonMetricsServer, clusterAddonExoscaleCCM},
+			toggles: map[string]bool{clusterAddonMetricsServer: false},
+			want:    []string{clusterAddonExoscaleCCM},
+		},
+		{
+			name:    "unmanaged addons are kept",
+			addons:  []string{"karpenter", clusterAddonExoscaleCCM},
+			toggles: map[string]bool{clusterAddonExoscaleCCM: false},
+			want:    []string{"karpenter"},
+		},
+		{
+			name:    "no duplicates",
+			addons:  []string{clusterAddonExoscaleCCM, clusterAddonExoscaleCCM},
+			toggles: map[string]bool{clusterAddonExoscaleCCM: true},
+			want:    []string{clusterAddonExoscaleCCM},
+		},
+		{
+			name: "none",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clusterAddons(tt.addons, tt.toggles))
+		})
+	}
+}
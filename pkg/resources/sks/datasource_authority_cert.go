@@ -0,0 +1,203 @@
+package sks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceAuthorityCertDescription = `Fetch a certificate authority (CA) certificate of an Exoscale [SKS](https://community.exoscale.com/documentation/sks/) cluster,
+e.g. to verify the kubelets serving certificates or to integrate with the aggregation layer from outside of the cluster configuration.
+
+Corresponding resource: [exoscale_sks_cluster](../resources/sks_cluster.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceAuthorityCert{}
+
+// DataSourceAuthorityCert defines the data source implementation.
+type DataSourceAuthorityCert struct {
+	client *exoscale.Client
+}
+
+// NewDataSourceAuthorityCert creates instance of DataSourceAuthorityCert.
+func NewDataSourceAuthorityCert() datasource.DataSource {
+	return &DataSourceAuthorityCert{}
+}
+
+// DataSourceAuthorityCertModel defines the data source data model.
+type DataSourceAuthorityCertModel struct {
+	ID          types.String `tfsdk:"id"`
+	Authority   types.String `tfsdk:"authority"`
+	Certificate types.String `tfsdk:"certificate"`
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Zone        types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceAuthorityCert) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sks_cluster_authority_cert"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceAuthorityCert) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceAuthorityCertDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrAuthorityCertIDDescription,
+				Computed:            true,
+			},
+			AttrAuthority: schema.StringAttribute{
+				MarkdownDescription: attrAuthorityDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(exoscale.GetSKSClusterAuthorityCertAuthorityAggregation),
+						string(exoscale.GetSKSClusterAuthorityCertAuthorityControlPlane),
+						string(exoscale.GetSKSClusterAuthorityCertAuthorityKubelet),
+					),
+				},
+			},
+			AttrCertificate: schema.StringAttribute{
+				MarkdownDescription: attrCertificateDescription,
+				Computed:            true,
+			},
+			AttrClusterID: schema.StringAttribute{
+				MarkdownDescription: attrClusterIDDescription,
+				Required:            true,
+			},
+			AttrExpiresAt: schema.StringAttribute{
+				MarkdownDescription: attrExpiresAtDescription,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up data source dependencies.
+func (d *DataSourceAuthorityCert) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceAuthorityCert) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceAuthorityCertModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(data.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID, err := exoscale.ParseUUID(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterID),
+			"unable to parse cluster ID",
+			err.Error(),
+		)
+		return
+	}
+
+	cert, err := client.GetSKSClusterAuthorityCert(
+		ctx,
+		clusterID,
+		exoscale.GetSKSClusterAuthorityCertAuthority(data.Authority.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS cluster authority certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	certificate, expiresAt, err := parseAuthorityCert(cert.Cacert)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse SKS cluster authority certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.ClusterID.ValueString(), data.Authority.ValueString()))
+	data.Certificate = types.StringValue(certificate)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
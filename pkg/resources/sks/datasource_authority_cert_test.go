@@ -0,0 +1,36 @@
+package sks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testDataSourceAuthorityCert(t *testing.T) {
+	dataSourceName := "data.exoscale_sks_cluster_authority_cert.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/004.authority_cert.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "certificate",
+						"exoscale_sks_cluster.test", "kubelet_ca",
+					),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,97 @@
+package sks_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testDataSourceCluster(t *testing.T) {
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	clusterName := fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID)
+	config := testutils.ParseTestdataConfig("./testdata/010.datasources.tf.tmpl", &testdataSpec)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// exoscale_sks_cluster
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_name", "name", clusterName),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_cluster.by_name", "id",
+						"exoscale_sks_cluster.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_cluster.by_name", "version",
+						"exoscale_sks_cluster.test", "version",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_cluster.by_name", "endpoint",
+						"exoscale_sks_cluster.test", "endpoint",
+					),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_name", "labels.test", clusterName),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_name", "cni", "calico"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_name", "service_level", "pro"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_name", "nodepools.#", "1"),
+					resource.TestCheckResourceAttrSet("data.exoscale_sks_cluster.by_name", "created_at"),
+					resource.TestCheckResourceAttrSet("data.exoscale_sks_cluster.by_name", "state"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster.by_id", "name", clusterName),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_cluster.by_id", "id",
+						"exoscale_sks_cluster.test", "id",
+					),
+
+					// exoscale_sks_cluster_list
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster_list.by_name", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster_list.by_name", "clusters.0.name", clusterName),
+					resource.TestCheckResourceAttr("data.exoscale_sks_cluster_list.by_labels", "clusters.#", "2"),
+
+					// exoscale_sks_nodepool
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_nodepool.by_name", "id",
+						"exoscale_sks_nodepool.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_nodepool.by_name", "instance_pool_id",
+						"exoscale_sks_nodepool.test", "instance_pool_id",
+					),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool.by_name", "name", clusterName+"-nodepool"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool.by_name", "disk_size", "20"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool.by_name", "size", "1"),
+					resource.TestCheckResourceAttrSet("data.exoscale_sks_nodepool.by_name", "template_id"),
+					resource.TestCheckResourceAttrSet("data.exoscale_sks_nodepool.by_name", "version"),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_nodepool.by_id", "id",
+						"exoscale_sks_nodepool.test_2", "id",
+					),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool.by_id", "name", clusterName+"-nodepool-2"),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool.by_id", "size", "2"),
+
+					// exoscale_sks_nodepool_list
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool_list.by_size", "nodepools.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.exoscale_sks_nodepool_list.by_size", "nodepools.0.name",
+						clusterName+"-nodepool-2",
+					),
+					resource.TestCheckResourceAttr("data.exoscale_sks_nodepool_list.by_name", "nodepools.#", "2"),
+				),
+			},
+			{
+				Config:      config + testutils.ParseTestdataConfig("./testdata/015.datasource_nodepool_not_found.tf.tmpl", &testdataSpec),
+				ExpectError: regexp.MustCompile("no nodepool matches cluster"),
+			},
+		},
+	})
+}
@@ -0,0 +1,246 @@
+package sks
+
+import (
+	"context"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceDeprecatedResourcesDescription = `List the resources deployed in an Exoscale [SKS](https://community.exoscale.com/documentation/sks/) cluster
+which use deprecated Kubernetes API versions, to be migrated before upgrading the cluster to a release which no longer serves them.
+
+Corresponding resource: [exoscale_sks_cluster](../resources/sks_cluster.md) (see its ` + "`fail_upgrade_on_deprecated_resources`" + ` argument).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceDeprecatedResources{}
+
+// DataSourceDeprecatedResources defines the data source implementation.
+type DataSourceDeprecatedResources struct {
+	client *exoscale.Client
+}
+
+// NewDataSourceDeprecatedResources creates instance of DataSourceDeprecatedResources.
+func NewDataSourceDeprecatedResources() datasource.DataSource {
+	return &DataSourceDeprecatedResources{}
+}
+
+// DataSourceDeprecatedResourcesModel defines the data source data model.
+type DataSourceDeprecatedResourcesModel struct {
+	ID            types.String              `tfsdk:"id"`
+	ClusterID     types.String              `tfsdk:"cluster_id"`
+	Resources     []DeprecatedResourceModel `tfsdk:"resources"`
+	TargetVersion types.String              `tfsdk:"target_version"`
+	Zone          types.String              `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DeprecatedResourceModel defines a deprecated resource data model.
+type DeprecatedResourceModel struct {
+	Group          types.String `tfsdk:"group"`
+	Properties     types.Map    `tfsdk:"properties"`
+	RemovedRelease types.String `tfsdk:"removed_release"`
+	Resource       types.String `tfsdk:"resource"`
+	Subresource    types.String `tfsdk:"subresource"`
+	Version        types.String `tfsdk:"version"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceDeprecatedResources) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sks_cluster_deprecated_resources"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceDeprecatedResources) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceDeprecatedResourcesDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrIDDescription,
+				Computed:            true,
+			},
+			AttrClusterID: schema.StringAttribute{
+				MarkdownDescription: attrClusterIDDescription,
+				Required:            true,
+			},
+			AttrDeprecatedResources: schema.ListNestedAttribute{
+				MarkdownDescription: attrDeprecatedResourcesDescription,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						AttrDeprecatedResourceGroup: schema.StringAttribute{
+							MarkdownDescription: attrDeprecatedResourceGroupDescription,
+							Computed:            true,
+						},
+						AttrDeprecatedResourceProperties: schema.MapAttribute{
+							MarkdownDescription: attrDeprecatedResourcePropertiesDescription,
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						AttrDeprecatedResourceRemovedRelease: schema.StringAttribute{
+							MarkdownDescription: attrDeprecatedResourceRemovedReleaseDescription,
+							Computed:            true,
+						},
+						AttrDeprecatedResourceResource: schema.StringAttribute{
+							MarkdownDescription: attrDeprecatedResourceResourceDescription,
+							Computed:            true,
+						},
+						AttrDeprecatedResourceSubresource: schema.StringAttribute{
+							MarkdownDescription: attrDeprecatedResourceSubresourceDescription,
+							Computed:            true,
+						},
+						AttrDeprecatedResourceVersion: schema.StringAttribute{
+							MarkdownDescription: attrDeprecatedResourceVersionDescription,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			AttrTargetVersion: schema.StringAttribute{
+				MarkdownDescription: attrTargetVersionDescription,
+				Optional:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up data source dependencies.
+func (d *DataSourceDeprecatedResources) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceDeprecatedResources) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceDeprecatedResourcesModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(data.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID, err := exoscale.ParseUUID(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterID),
+			"unable to parse cluster ID",
+			err.Error(),
+		)
+		return
+	}
+
+	resources, err := ListDeprecatedResources(ctx, client, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to list deprecated resources",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Resources = make([]DeprecatedResourceModel, 0, len(resources))
+	for _, r := range resources {
+		if v := data.TargetVersion.ValueString(); v != "" {
+			removed, err := r.RemovedBy(v)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(AttrTargetVersion),
+					"invalid target version",
+					err.Error(),
+				)
+				return
+			}
+			if !removed {
+				continue
+			}
+		}
+
+		properties, diags := types.MapValueFrom(ctx, types.StringType, r.Properties)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Resources = append(data.Resources, DeprecatedResourceModel{
+			Group:          types.StringValue(r.Group),
+			Properties:     properties,
+			RemovedRelease: types.StringValue(r.RemovedRelease),
+			Resource:       types.StringValue(r.Resource),
+			Subresource:    types.StringValue(r.Subresource),
+			Version:        types.StringValue(r.Version),
+		})
+	}
+
+	data.ID = data.ClusterID
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
@@ -0,0 +1,37 @@
+package sks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testDataSourceDeprecatedResources(t *testing.T) {
+	dataSourceName := "data.exoscale_sks_cluster_deprecated_resources.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/001.deprecated_resources.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "id",
+						"exoscale_sks_cluster.test", "id",
+					),
+					// A fresh cluster has no deprecated resources deployed.
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "0"),
+				),
+			},
+		},
+	})
+}
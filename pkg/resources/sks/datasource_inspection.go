@@ -0,0 +1,202 @@
+package sks
+
+import (
+	"context"
+	"encoding/json"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceInspectionDescription = `Fetch the inspection report of an Exoscale [SKS](https://community.exoscale.com/documentation/sks/) cluster,
+which helps troubleshooting common problems of Kubernetes clusters. Inspections are run every couple of minutes.
+
+Corresponding resource: [exoscale_sks_cluster](../resources/sks_cluster.md).`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceInspection{}
+
+// DataSourceInspection defines the data source implementation.
+type DataSourceInspection struct {
+	client *exoscale.Client
+}
+
+// NewDataSourceInspection creates instance of DataSourceInspection.
+func NewDataSourceInspection() datasource.DataSource {
+	return &DataSourceInspection{}
+}
+
+// DataSourceInspectionModel defines the data source data model.
+type DataSourceInspectionModel struct {
+	ID        types.String  `tfsdk:"id"`
+	ClusterID types.String  `tfsdk:"cluster_id"`
+	JSON      types.String  `tfsdk:"json"`
+	Report    types.Dynamic `tfsdk:"report"`
+	Zone      types.String  `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceInspection) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sks_cluster_inspection"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceInspection) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceInspectionDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrIDDescription,
+				Computed:            true,
+			},
+			AttrClusterID: schema.StringAttribute{
+				MarkdownDescription: attrClusterIDDescription,
+				Required:            true,
+			},
+			AttrInspectionJSON: schema.StringAttribute{
+				MarkdownDescription: attrInspectionJSONDescription,
+				Computed:            true,
+			},
+			AttrInspectionReport: schema.DynamicAttribute{
+				MarkdownDescription: attrInspectionReportDescription,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up data source dependencies.
+func (d *DataSourceInspection) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceInspection) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceInspectionModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(data.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID, err := exoscale.ParseUUID(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterID),
+			"unable to parse cluster ID",
+			err.Error(),
+		)
+		return
+	}
+
+	inspection, err := client.GetSKSClusterInspection(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS cluster inspection",
+			err.Error(),
+		)
+		return
+	}
+
+	raw, err := json.Marshal(inspection)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to encode SKS cluster inspection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Decode the report again rather than using the API response as is, to get plain JSON values.
+	var report any
+	if err := json.Unmarshal(raw, &report); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to decode SKS cluster inspection",
+			err.Error(),
+		)
+		return
+	}
+
+	value, diags := jsonToValue(ctx, report)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ClusterID
+	data.JSON = types.StringValue(string(raw))
+	data.Report = types.DynamicValue(value)
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
@@ -0,0 +1,201 @@
+package sks
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const DataSourceVersionsDescription = `List the Kubernetes versions available for Exoscale [SKS](https://community.exoscale.com/documentation/sks/) clusters,
+optionally restricted to a given minor release to pin clusters to its latest patch version.
+
+Corresponding resource: [exoscale_sks_cluster](../resources/sks_cluster.md).`
+
+var minorVersionRegexp = regexp.MustCompile(`^v?\d+\.\d+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &DataSourceVersions{}
+
+// DataSourceVersions defines the data source implementation.
+type DataSourceVersions struct {
+	client *exoscale.Client
+}
+
+// NewDataSourceVersions creates instance of DataSourceVersions.
+func NewDataSourceVersions() datasource.DataSource {
+	return &DataSourceVersions{}
+}
+
+// DataSourceVersionsModel defines the data source data model.
+type DataSourceVersionsModel struct {
+	ID                types.String `tfsdk:"id"`
+	IncludeDeprecated types.Bool   `tfsdk:"include_deprecated"`
+	LatestVersion     types.String `tfsdk:"latest_version"`
+	Minor             types.String `tfsdk:"minor"`
+	Versions          []string     `tfsdk:"versions"`
+	Zone              types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies data source name.
+func (d *DataSourceVersions) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sks_versions"
+}
+
+// Schema defines data source attributes.
+func (d *DataSourceVersions) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: DataSourceVersionsDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrVersionsIDDescription,
+				Computed:            true,
+			},
+			AttrIncludeDeprecated: schema.BoolAttribute{
+				MarkdownDescription: attrIncludeDeprecatedDescription,
+				Optional:            true,
+			},
+			AttrLatestVersion: schema.StringAttribute{
+				MarkdownDescription: attrLatestVersionDescription,
+				Computed:            true,
+			},
+			AttrMinor: schema.StringAttribute{
+				MarkdownDescription: attrMinorDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(minorVersionRegexp, `must be a minor release such as "1.30"`),
+				},
+			},
+			AttrVersions: schema.ListAttribute{
+				MarkdownDescription: attrVersionsDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: attrZoneDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Read: true,
+			}),
+		},
+	}
+}
+
+// Configure sets up data source dependencies.
+func (d *DataSourceVersions) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// Read defines how the data source updates Terraform's state to reflect the retrieved data.
+func (d *DataSourceVersions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceVersionsModel
+
+	// Load Terraform config into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := data.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		d.client,
+		exoscale.ZoneName(data.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	versions, err := client.ListSKSClusterVersions(
+		ctx,
+		exoscale.ListSKSClusterVersionsWithIncludeDeprecated(strconv.FormatBool(data.IncludeDeprecated.ValueBool())),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to list SKS cluster versions",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Versions = selectVersions(versions.SKSClusterVersions, data.Minor.ValueString())
+	if len(data.Versions) == 0 && data.Minor.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"no SKS cluster version found",
+			"no version returned by the API",
+		)
+		return
+	}
+	if len(data.Versions) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrMinor),
+			"no SKS cluster version found",
+			fmt.Sprintf("no version available for minor release %q", data.Minor.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(data.Versions, "")))))
+	data.LatestVersion = types.StringValue(data.Versions[0])
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Trace(ctx, "datasource read done", map[string]interface{}{
+		"id": data.ID,
+	})
+}
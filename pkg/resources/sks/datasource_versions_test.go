@@ -0,0 +1,37 @@
+package sks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testDataSourceVersions(t *testing.T) {
+	testdataSpec := testutils.TestdataSpec{
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/002.versions.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.exoscale_sks_versions.all", "versions.0"),
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_versions.all", "latest_version",
+						"data.exoscale_sks_versions.all", "versions.0",
+					),
+					// The latest version overall is also the latest patch of its minor release.
+					resource.TestCheckResourceAttrPair(
+						"data.exoscale_sks_versions.minor", "latest_version",
+						"data.exoscale_sks_versions.all", "latest_version",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package sks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+)
+
+// DeprecatedResource represents a resource deployed in an SKS cluster using a Kubernetes API
+// version which is deprecated, and will be removed in a future Kubernetes release.
+type DeprecatedResource struct {
+	Group          string
+	RemovedRelease string
+	Resource       string
+	Subresource    string
+	Version        string
+	Properties     map[string]string
+}
+
+func deprecatedResourceFromAPI(r exoscale.SKSClusterDeprecatedResource) DeprecatedResource {
+	return DeprecatedResource{
+		Group:          r["group"],
+		RemovedRelease: r["removed_release"],
+		Resource:       r["resource"],
+		Subresource:    r["subresource"],
+		Version:        r["version"],
+		Properties:     r,
+	}
+}
+
+// String returns the resource in the "group/version resource" format used by kubectl.
+func (r DeprecatedResource) String() string {
+	s := r.Version + " " + r.Resource
+	if r.Group != "" {
+		s = r.Group + "/" + s
+	}
+	if r.Subresource != "" {
+		s += "/" + r.Subresource
+	}
+
+	return s
+}
+
+// RemovedBy returns whether the resource API version is no longer served by the given
+// Kubernetes version. Resources without a (valid) removal release are never reported.
+func (r DeprecatedResource) RemovedBy(version string) (bool, error) {
+	removed, ok := parseMinorVersion(r.RemovedRelease)
+	if !ok {
+		return false, nil
+	}
+
+	target, ok := parseMinorVersion(version)
+	if !ok {
+		return false, fmt.Errorf("invalid Kubernetes version %q", version)
+	}
+
+	if target[0] != removed[0] {
+		return target[0] > removed[0], nil
+	}
+
+	return target[1] >= removed[1], nil
+}
+
+// ListDeprecatedResources returns the deprecated resources in use in an SKS cluster.
+func ListDeprecatedResources(ctx context.Context, client *exoscale.Client, clusterID exoscale.UUID) ([]DeprecatedResource, error) {
+	resources, err := client.ListSKSClusterDeprecatedResources(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list SKS cluster deprecated resources: %w", err)
+	}
+
+	ret := make([]DeprecatedResource, 0, len(resources))
+	for _, r := range resources {
+		ret = append(ret, deprecatedResourceFromAPI(r))
+	}
+
+	return ret, nil
+}
+
+// CheckDeprecatedResources returns an error if some deprecated resources in use in an SKS cluster
+// would be removed by upgrading the cluster to the given Kubernetes version.
+func CheckDeprecatedResources(ctx context.Context, client *exoscale.Client, clusterID exoscale.UUID, version string) error {
+	resources, err := ListDeprecatedResources(ctx, client, clusterID)
+	if err != nil {
+		return err
+	}
+
+	removed := make([]string, 0)
+	for _, r := range resources {
+		ok, err := r.RemovedBy(version)
+		if err != nil {
+			return err
+		}
+		if ok {
+			removed = append(removed, fmt.Sprintf("%s (removed in %s)", r, r.RemovedRelease))
+		}
+	}
+
+	if len(removed) > 0 {
+		return fmt.Errorf(
+			"the cluster uses resources which are no longer served by Kubernetes %s: %s",
+			version,
+			strings.Join(removed, ", "),
+		)
+	}
+
+	return nil
+}
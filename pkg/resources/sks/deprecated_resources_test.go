@@ -0,0 +1,52 @@
+package sks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecatedResourceRemovedBy(t *testing.T) {
+	r := DeprecatedResource{
+		Group:          "policy",
+		RemovedRelease: "1.25",
+		Resource:       "podsecuritypolicies",
+		Version:        "v1beta1",
+	}
+
+	for version, expected := range map[string]bool{
+		"1.24.9":  false,
+		"1.25":    true,
+		"1.25.0":  true,
+		"v1.25.3": true,
+		"1.30.2":  true,
+		"2.0.0":   true,
+	} {
+		removed, err := r.RemovedBy(version)
+		require.NoError(t, err, version)
+		assert.Equal(t, expected, removed, version)
+	}
+
+	_, err := r.RemovedBy("latest")
+	assert.Error(t, err)
+
+	// Resources without a removal release are never reported.
+	removed, err := DeprecatedResource{Resource: "foo"}.RemovedBy("1.30.2")
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestDeprecatedResourceString(t *testing.T) {
+	assert.Equal(t, "policy/v1beta1 podsecuritypolicies", DeprecatedResource{
+		Group:    "policy",
+		Resource: "podsecuritypolicies",
+		Version:  "v1beta1",
+	}.String())
+
+	assert.Equal(t, "v1 pods/status", DeprecatedResource{
+		Resource:    "pods",
+		Subresource: "status",
+		Version:     "v1",
+	}.String())
+}
@@ -0,0 +1,69 @@
+package sks
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonToValue converts a decoded JSON value into a Terraform value: objects are converted to objects,
+// arrays to tuples (their items may be of different types), and nulls to null strings.
+func jsonToValue(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case string:
+		return types.StringValue(v), nil
+	case []any:
+		var diags diag.Diagnostics
+
+		elemTypes := make([]attr.Type, len(v))
+		elems := make([]attr.Value, len(v))
+		for i, item := range v {
+			value, d := jsonToValue(ctx, item)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elemTypes[i] = value.Type(ctx)
+			elems[i] = value
+		}
+
+		value, d := types.TupleValue(elemTypes, elems)
+		diags.Append(d...)
+
+		return value, diags
+	case map[string]any:
+		var diags diag.Diagnostics
+
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, item := range v {
+			value, d := jsonToValue(ctx, item)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			attrTypes[k] = value.Type(ctx)
+			attrs[k] = value
+		}
+
+		value, d := types.ObjectValue(attrTypes, attrs)
+		diags.Append(d...)
+
+		return value, diags
+	default:
+		var diags diag.Diagnostics
+		diags.AddError("unsupported JSON value", fmt.Sprintf("unsupported value type %T", v))
+
+		return nil, diags
+	}
+}
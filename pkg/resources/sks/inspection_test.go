@@ -0,0 +1,46 @@
+package sks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONToValue(t *testing.T) {
+	var v any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"errors": [{"name": "dns", "message": "unreachable"}, "timeout"],
+		"healthy": false,
+		"nodes": 3,
+		"comment": null
+	}`), &v))
+
+	value, diags := jsonToValue(context.Background(), v)
+	require.False(t, diags.HasError(), diags)
+
+	obj, ok := value.(types.Object)
+	require.True(t, ok)
+
+	attrs := obj.Attributes()
+	assert.Equal(t, types.BoolValue(false), attrs["healthy"])
+	assert.Equal(t, types.NumberValue(big.NewFloat(3)), attrs["nodes"])
+	assert.Equal(t, types.StringNull(), attrs["comment"])
+
+	errors, ok := attrs["errors"].(types.Tuple)
+	require.True(t, ok)
+	require.Len(t, errors.Elements(), 2)
+	assert.Equal(t, types.StringValue("timeout"), errors.Elements()[1])
+
+	first, ok := errors.Elements()[0].(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, map[string]attr.Value{
+		"message": types.StringValue("unreachable"),
+		"name":    types.StringValue("dns"),
+	}, first.Attributes())
+}
@@ -0,0 +1,158 @@
+package sks
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// kubeconfig holds the values extracted from a Kubeconfig generated by the API.
+type kubeconfig struct {
+	Host                 string
+	ClusterCACertificate string
+	ClientCertificate    string
+	ClientKey            string
+
+	ClusterCertificates []*x509.Certificate
+	ClientCertificates  []*x509.Certificate
+}
+
+// parseKubeconfig decodes a Kubeconfig (YAML content) as returned by the API.
+func parseKubeconfig(content string) (*kubeconfig, error) {
+	var data struct {
+		Clusters []struct {
+			Cluster struct {
+				CertificateAuthorityData string `yaml:"certificate-authority-data"`
+				Server                   string `yaml:"server"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+		Users []struct {
+			User struct {
+				ClientCertificateData string `yaml:"client-certificate-data"`
+				ClientKeyData         string `yaml:"client-key-data"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+
+	if err := yaml.Unmarshal([]byte(content), &data); err != nil {
+		return nil, fmt.Errorf("error decoding kubeconfig: %w", err)
+	}
+
+	ret := kubeconfig{
+		ClusterCertificates: make([]*x509.Certificate, 0, len(data.Clusters)),
+		ClientCertificates:  make([]*x509.Certificate, 0, len(data.Users)),
+	}
+
+	for i, cluster := range data.Clusters {
+		rawPEMData, certificate, err := kubeconfigRawPEMDataToCertificate(cluster.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cluster CA certificate: %w", err)
+		}
+
+		if i == 0 {
+			ret.Host = cluster.Cluster.Server
+			ret.ClusterCACertificate = string(rawPEMData)
+		}
+
+		ret.ClusterCertificates = append(ret.ClusterCertificates, certificate)
+	}
+
+	for i, user := range data.Users {
+		rawPEMData, certificate, err := kubeconfigRawPEMDataToCertificate(user.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+
+		if i == 0 {
+			key, err := base64.StdEncoding.DecodeString(user.User.ClientKeyData)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding base64 kubeconfig client key: %w", err)
+			}
+
+			ret.ClientCertificate = string(rawPEMData)
+			ret.ClientKey = string(key)
+		}
+
+		ret.ClientCertificates = append(ret.ClientCertificates, certificate)
+	}
+
+	return &ret, nil
+}
+
+// KubeconfigExtractCertificates returns the cluster CA certificates and the client certificates
+// of a Kubeconfig (YAML content).
+func KubeconfigExtractCertificates(content string) ([]*x509.Certificate, []*x509.Certificate, error) {
+	if len(content) == 0 {
+		return []*x509.Certificate{}, []*x509.Certificate{}, nil
+	}
+
+	k, err := parseKubeconfig(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.ClusterCertificates, k.ClientCertificates, nil
+}
+
+func kubeconfigRawPEMDataToCertificate(b64PEMData string) ([]byte, *x509.Certificate, error) {
+	rawPEMData, err := base64.StdEncoding.DecodeString(b64PEMData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding base64 kubeconfig certificate: %w", err)
+	}
+
+	parsedPEMData, _ := pem.Decode(rawPEMData)
+	if parsedPEMData == nil {
+		return nil, nil, errors.New("no PEM data found")
+	}
+
+	parsedCertificate, err := x509.ParseCertificate(parsedPEMData.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse kubeconfig x509 certificate: %w", err)
+	}
+
+	return rawPEMData, parsedCertificate, nil
+}
+
+func (k *kubeconfig) certificates() []*x509.Certificate {
+	return append(append([]*x509.Certificate{}, k.ClusterCertificates...), k.ClientCertificates...)
+}
+
+// ID returns the Kubeconfig identifier, made of the serial numbers of its certificates.
+func (k *kubeconfig) ID() string {
+	certificateIDs := []string{}
+	for _, cert := range k.certificates() {
+		certificateIDs = append(certificateIDs, cert.SerialNumber.String())
+	}
+
+	return strings.Join(certificateIDs, ":")
+}
+
+// ExpiresAt returns the earliest expiration date of the Kubeconfig certificates.
+func (k *kubeconfig) ExpiresAt() time.Time {
+	var expiresAt time.Time
+	for _, cert := range k.certificates() {
+		if expiresAt.IsZero() || cert.NotAfter.Before(expiresAt) {
+			expiresAt = cert.NotAfter
+		}
+	}
+
+	return expiresAt.UTC()
+}
+
+// ReadyForRenewal reports whether any of the Kubeconfig certificates expires within
+// earlyRenewal of now.
+func (k *kubeconfig) ReadyForRenewal(earlyRenewal time.Duration, now time.Time) bool {
+	for _, cert := range k.certificates() {
+		if cert.NotAfter.Add(-earlyRenewal).Sub(now) <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
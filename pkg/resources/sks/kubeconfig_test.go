@@ -0,0 +1,82 @@
+package sks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKubeconfigCertificate(t *testing.T, serial int64, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: fmt.Sprintf("test-%d", serial)},
+		NotBefore:    notAfter.AddDate(0, -1, 0),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestParseKubeconfig(t *testing.T) {
+	clusterNotAfter := time.Date(2036, 1, 2, 3, 4, 5, 0, time.UTC)
+	clientNotAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	clusterCA, _ := testKubeconfigCertificate(t, 1, clusterNotAfter)
+	clientCert, clientKey := testKubeconfigCertificate(t, 2, clientNotAfter)
+
+	content := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: https://test.sks.exoscale.com:443
+  name: test
+users:
+- name: kube-user
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`,
+		base64.StdEncoding.EncodeToString(clusterCA),
+		base64.StdEncoding.EncodeToString(clientCert),
+		base64.StdEncoding.EncodeToString(clientKey),
+	)
+
+	k, err := parseKubeconfig(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://test.sks.exoscale.com:443", k.Host)
+	assert.Equal(t, string(clusterCA), k.ClusterCACertificate)
+	assert.Equal(t, string(clientCert), k.ClientCertificate)
+	assert.Equal(t, string(clientKey), k.ClientKey)
+	assert.Equal(t, "1:2", k.ID())
+	assert.Equal(t, clientNotAfter, k.ExpiresAt())
+
+	assert.False(t, k.ReadyForRenewal(0, clientNotAfter.Add(-time.Hour)))
+	assert.True(t, k.ReadyForRenewal(2*time.Hour, clientNotAfter.Add(-time.Hour)))
+	assert.True(t, k.ReadyForRenewal(0, clientNotAfter))
+
+	_, err = parseKubeconfig("clusters: [{cluster: {certificate-authority-data: bG9sbm9wZQ==}}]")
+	assert.Error(t, err)
+}
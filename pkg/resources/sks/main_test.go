@@ -0,0 +1,16 @@
+package sks_test
+
+import "testing"
+
+func TestSKS(t *testing.T) {
+	t.Run("DataSourceAuthorityCert", testDataSourceAuthorityCert)
+	t.Run("DataSourceCluster", testDataSourceCluster)
+	t.Run("DataSourceDeprecatedResources", testDataSourceDeprecatedResources)
+	t.Run("DataSourceInspection", testDataSourceInspection)
+	t.Run("DataSourceVersions", testDataSourceVersions)
+	t.Run("ResourceCluster", testResourceCluster)
+	t.Run("ResourceClusterServiceLevel", testResourceClusterServiceLevel)
+	t.Run("ResourceKubeconfig", testResourceKubeconfig)
+	t.Run("ResourceNodepool", testResourceNodepool)
+	t.Run("ResourceUpgradeFromSDK", testResourceUpgradeFromSDK)
+}
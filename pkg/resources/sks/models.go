@@ -0,0 +1,104 @@
+package sks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterOIDCModel defines nested data model.
+type ClusterOIDCModel struct {
+	ClientID       types.String `tfsdk:"client_id"`
+	GroupsClaim    types.String `tfsdk:"groups_claim"`
+	GroupsPrefix   types.String `tfsdk:"groups_prefix"`
+	IssuerURL      types.String `tfsdk:"issuer_url"`
+	RequiredClaim  types.Map    `tfsdk:"required_claim"`
+	UsernameClaim  types.String `tfsdk:"username_claim"`
+	UsernamePrefix types.String `tfsdk:"username_prefix"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m ClusterOIDCModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		AttrClusterOIDCClientID:       types.StringType,
+		AttrClusterOIDCGroupsClaim:    types.StringType,
+		AttrClusterOIDCGroupsPrefix:   types.StringType,
+		AttrClusterOIDCIssuerURL:      types.StringType,
+		AttrClusterOIDCRequiredClaim:  types.MapType{ElemType: types.StringType},
+		AttrClusterOIDCUsernameClaim:  types.StringType,
+		AttrClusterOIDCUsernamePrefix: types.StringType,
+	}
+}
+
+// NodepoolKubeletImageGCModel defines nested data model.
+type NodepoolKubeletImageGCModel struct {
+	HighThreshold types.Int64  `tfsdk:"high_threshold"`
+	LowThreshold  types.Int64  `tfsdk:"low_threshold"`
+	MinAge        types.String `tfsdk:"min_age"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m NodepoolKubeletImageGCModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		AttrNodepoolKubeletImageGCHighThreshold: types.Int64Type,
+		AttrNodepoolKubeletImageGCLowThreshold:  types.Int64Type,
+		AttrNodepoolKubeletImageGCMinAge:        types.StringType,
+	}
+}
+
+// NodepoolNodeUpgradeModel defines nested data model.
+type NodepoolNodeUpgradeModel struct {
+	BatchSize           types.Int64  `tfsdk:"batch_size"`
+	ClusterVersion      types.String `tfsdk:"cluster_version"`
+	PauseBetweenBatches types.String `tfsdk:"pause_between_batches"`
+}
+
+// Types returns nested data model types to be used for conversion.
+func (m NodepoolNodeUpgradeModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		AttrNodepoolNodeUpgradeBatchSize:           types.Int64Type,
+		AttrNodepoolNodeUpgradeClusterVersion:      types.StringType,
+		AttrNodepoolNodeUpgradePauseBetweenBatches: types.StringType,
+	}
+}
+
+// stringsFromSet returns the elements of a set of strings (empty if null).
+func stringsFromSet(ctx context.Context, v types.Set) ([]string, diag.Diagnostics) {
+	ret := []string{}
+	if v.IsNull() || v.IsUnknown() {
+		return ret, nil
+	}
+
+	diags := v.ElementsAs(ctx, &ret, false)
+
+	return ret, diags
+}
+
+// nullIfEmptyString returns a null value in place of an empty string.
+func nullIfEmptyString(v types.String) types.String {
+	if v.ValueString() == "" {
+		return types.StringNull()
+	}
+
+	return v
+}
+
+// nullIfEmptyMap returns a null value in place of an empty map of strings.
+func nullIfEmptyMap(v types.Map) types.Map {
+	if len(v.Elements()) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	return v
+}
+
+// nullIfEmptySet returns a null value in place of an empty set of strings.
+func nullIfEmptySet(v types.Set) types.Set {
+	if len(v.Elements()) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	return v
+}
@@ -0,0 +1,225 @@
+package sks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+)
+
+const (
+	defaultNodepoolDiskSize       int64 = 50
+	defaultNodepoolInstancePrefix       = "pool"
+	defaultNodepoolBatchSize      int64 = 1
+
+	nodepoolAddonStorageLVM = "storage-lvm"
+)
+
+// nodepoolTaintRegexp matches the taints formatted as VALUE:EFFECT.
+var nodepoolTaintRegexp = regexp.MustCompile(`^[^:]+:(NoSchedule|PreferNoSchedule|NoExecute)$`)
+
+// nodepoolNodesPollInterval is the interval between two checks of the nodepool nodes state.
+var nodepoolNodesPollInterval = 5 * time.Second
+
+// parseNodepoolTaint parses a CLI-formatted Kubernetes Node taint
+// description formatted as VALUE:EFFECT, and returns discrete values
+// for the value/effect as exoscale.SKSNodepoolTaint, or an error if
+// the input value parsing failed.
+func parseNodepoolTaint(v string) (exoscale.SKSNodepoolTaint, error) {
+	parts := strings.SplitN(v, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return exoscale.SKSNodepoolTaint{}, errors.New("expected format VALUE:EFFECT")
+	}
+
+	return exoscale.SKSNodepoolTaint{
+		Effect: exoscale.SKSNodepoolTaintEffect(parts[1]),
+		Value:  parts[0],
+	}, nil
+}
+
+// nodepoolInstanceTypeName returns the `<family>.<size>` name of an instance type.
+func nodepoolInstanceTypeName(instanceType *exoscale.InstanceType) string {
+	return fmt.Sprintf(
+		"%s.%s",
+		strings.ToLower(string(instanceType.Family)),
+		strings.ToLower(string(instanceType.Size)),
+	)
+}
+
+// sameInstanceTypeName reports whether two instance type names designate the same type,
+// ignoring case differences and the default "standard" family.
+func sameInstanceTypeName(a, b string) bool {
+	normalize := func(name string) string {
+		name = strings.ToLower(name)
+		if !strings.Contains(name, ".") {
+			name = string(exoscale.InstanceTypeFamilyStandard) + "." + name
+		}
+		return name
+	}
+
+	return normalize(a) == normalize(b)
+}
+
+// nodepoolOutdatedNodes returns the nodes of an SKS Nodepool which don't run the nodepool template,
+// i.e. which have been created before the parent cluster was upgraded.
+func nodepoolOutdatedNodes(ctx context.Context, client *exoscale.Client, nodepool *exoscale.SKSNodepool) ([]exoscale.UUID, error) {
+	if nodepool.InstancePool == nil || nodepool.Template == nil {
+		return nil, nil
+	}
+
+	instances, err := client.ListInstances(
+		ctx,
+		exoscale.ListInstancesWithManagerID(nodepool.InstancePool.ID),
+		exoscale.ListInstancesWithManagerType(exoscale.ListInstancesManagerTypeInstancePool),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list nodepool instances: %w", err)
+	}
+
+	outdated := make([]exoscale.UUID, 0)
+	for _, instance := range instances.Instances {
+		if instance.Template != nil && instance.Template.ID != nodepool.Template.ID {
+			outdated = append(outdated, instance.ID)
+		}
+	}
+
+	return outdated, nil
+}
+
+// upgradeNodepoolNodes evicts the outdated nodes of an SKS Nodepool in batches, scaling the nodepool
+// back to its size and waiting for the replacement nodes to be running after each batch.
+func upgradeNodepoolNodes(
+	ctx context.Context,
+	client *exoscale.Client,
+	clusterID, nodepoolID exoscale.UUID,
+	size, batchSize int64,
+	pause time.Duration,
+) error {
+	nodepool, err := client.GetSKSNodepool(ctx, clusterID, nodepoolID)
+	if err != nil {
+		return err
+	}
+
+	outdated, err := nodepoolOutdatedNodes(ctx, client, nodepool)
+	if err != nil {
+		return err
+	}
+
+	step := int(batchSize)
+	for start := 0; start < len(outdated); start += step {
+		batch := outdated[start:min(start+step, len(outdated))]
+
+		tflog.Debug(ctx, "evicting outdated SKS nodepool nodes", map[string]interface{}{
+			"id":    nodepoolID,
+			"nodes": batch,
+		})
+
+		if err := await(ctx, client)(client.EvictSKSNodepoolMembers(
+			ctx,
+			clusterID,
+			nodepoolID,
+			exoscale.EvictSKSNodepoolMembersRequest{Instances: batch},
+		)); err != nil {
+			return fmt.Errorf("unable to evict nodepool nodes: %w", err)
+		}
+
+		// Eviction shrinks the nodepool: scaling it back up creates the replacement nodes.
+		if err := await(ctx, client)(client.ScaleSKSNodepool(
+			ctx,
+			clusterID,
+			nodepoolID,
+			exoscale.ScaleSKSNodepoolRequest{Size: size},
+		)); err != nil {
+			return fmt.Errorf("unable to scale nodepool: %w", err)
+		}
+
+		if err := waitForNodepoolNodes(ctx, client, nodepool, size); err != nil {
+			return err
+		}
+
+		if pause > 0 && start+step < len(outdated) {
+			select {
+			case <-time.After(pause):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForNodepoolNodes waits until the nodepool has the expected number of running nodes.
+func waitForNodepoolNodes(ctx context.Context, client *exoscale.Client, nodepool *exoscale.SKSNodepool, size int64) error {
+	ticker := time.NewTicker(nodepoolNodesPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			instances, err := client.ListInstances(
+				ctx,
+				exoscale.ListInstancesWithManagerID(nodepool.InstancePool.ID),
+				exoscale.ListInstancesWithManagerType(exoscale.ListInstancesManagerTypeInstancePool),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to list nodepool instances: %w", err)
+			}
+
+			running := int64(0)
+			for _, instance := range instances.Instances {
+				if instance.State == exoscale.InstanceStateRunning {
+					running++
+				}
+			}
+			if int64(len(instances.Instances)) == size && running == size {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for nodepool replacement nodes: %w", ctx.Err())
+		}
+	}
+}
+
+// withClearedNodepoolTaints returns a client setting empty taints in the body of its requests,
+// to clear them in a nodepool update: the API client omits empty taints from the update request,
+// and the API has no reset for them.
+func withClearedNodepoolTaints(client *exoscale.Client) *exoscale.Client {
+	return client.WithRequestInterceptor(func(_ context.Context, req *http.Request) error {
+		if req.Method != http.MethodPut || req.Body == nil {
+			return nil
+		}
+
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		if err := req.Body.Close(); err != nil {
+			return err
+		}
+
+		body := make(map[string]interface{})
+		if err := json.Unmarshal(data, &body); err != nil {
+			return err
+		}
+		body["taints"] = map[string]interface{}{}
+
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.ContentLength = int64(len(data))
+
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+package sks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestParseNodepoolTaint(t *testing.T) {
+	taint, err := parseNodepoolTaint("value:NoSchedule")
+	require.NoError(t, err)
+	assert.Equal(t, exoscale.SKSNodepoolTaint{
+		Effect: exoscale.SKSNodepoolTaintEffectNoSchedule,
+		Value:  "value",
+	}, taint)
+
+	for _, v := range []string{"", "value", "value:", ":NoSchedule"} {
+		_, err := parseNodepoolTaint(v)
+		assert.Error(t, err, v)
+	}
+}
+
+func TestNodepoolTaintRegexp(t *testing.T) {
+	for v, want := range map[string]bool{
+		"value:NoSchedule":       true,
+		"value:PreferNoSchedule": true,
+		"value:NoExecute":        true,
+		"value:Invalid":          false,
+		"value":                  false,
+		":NoSchedule":            false,
+	} {
+		assert.Equal(t, want, nodepoolTaintRegexp.MatchString(v), v)
+	}
+}
+
+func TestSameInstanceTypeName(t *testing.T) {
+	assert.True(t, sameInstanceTypeName("standard.medium", "standard.medium"))
+	assert.True(t, sameInstanceTypeName("Standard.Medium", "standard.medium"))
+	assert.True(t, sameInstanceTypeName("medium", "standard.medium"))
+	assert.False(t, sameInstanceTypeName("medium", "cpu.medium"))
+	assert.False(t, sameInstanceTypeName("standard.small", "standard.medium"))
+}
+
+func TestWithClearedNodepoolTaints(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(exoscale.Operation{})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := exoscale.NewClient(
+		credentials.NewStaticCredentials("EXOtest", "test"),
+		exoscale.ClientOptWithEndpoint(exoscale.Endpoint(server.URL)),
+	)
+	require.NoError(t, err)
+
+	_, err = withClearedNodepoolTaints(client).UpdateSKSNodepool(
+		context.Background(),
+		exoscale.UUID("8f5e3a4c-1b2d-4e6f-9a0b-7c8d9e0f1a2b"),
+		exoscale.UUID("2c3d4e5f-6a7b-4c8d-9e0f-1a2b3c4d5e6f"),
+		exoscale.UpdateSKSNodepoolRequest{Name: "test"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"deploy-target": nil,
+		"name":          "test",
+		"taints":        map[string]interface{}{},
+	}, body)
+}
@@ -0,0 +1,1185 @@
+package sks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const resourceClusterDescription = "Manage Exoscale [Scalable Kubernetes Service (SKS)](https://community.exoscale.com/documentation/sks/) Clusters."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceCluster{}
+var _ resource.ResourceWithImportState = &ResourceCluster{}
+var _ resource.ResourceWithModifyPlan = &ResourceCluster{}
+var _ resource.ResourceWithUpgradeState = &ResourceCluster{}
+
+// ResourceCluster defines the resource implementation.
+type ResourceCluster struct {
+	client *exoscale.Client
+}
+
+// NewResourceCluster creates instance of ResourceCluster.
+func NewResourceCluster() resource.Resource {
+	return &ResourceCluster{}
+}
+
+// ResourceClusterModel defines the resource data model.
+type ResourceClusterModel struct {
+	ID                types.String `tfsdk:"id"`
+	Addons            types.Set    `tfsdk:"addons"`
+	AggregationCA     types.String `tfsdk:"aggregation_ca"`
+	AutoUpgrade       types.Bool   `tfsdk:"auto_upgrade"`
+	CNI               types.String `tfsdk:"cni"`
+	ControlPlaneCA    types.String `tfsdk:"control_plane_ca"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	Description       types.String `tfsdk:"description"`
+	Endpoint          types.String `tfsdk:"endpoint"`
+	ExoscaleCCM       types.Bool   `tfsdk:"exoscale_ccm"`
+	ExoscaleCSI       types.Bool   `tfsdk:"exoscale_csi"`
+	FailUpgrade       types.Bool   `tfsdk:"fail_upgrade_on_deprecated_resources"`
+	KubeletCA         types.String `tfsdk:"kubelet_ca"`
+	Labels            types.Map    `tfsdk:"labels"`
+	MetricsServer     types.Bool   `tfsdk:"metrics_server"`
+	Name              types.String `tfsdk:"name"`
+	Nodepools         types.Set    `tfsdk:"nodepools"`
+	OIDC              types.Object `tfsdk:"oidc"`
+	RotateCCMCreds    types.String `tfsdk:"rotate_ccm_credentials_trigger"`
+	RotateOperatorsCA types.String `tfsdk:"rotate_operators_ca_trigger"`
+	ServiceLevel      types.String `tfsdk:"service_level"`
+	State             types.String `tfsdk:"state"`
+	Version           types.String `tfsdk:"version"`
+	Zone              types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// addonToggles returns the addon toggles of the model, indexed by attribute name.
+func (m *ResourceClusterModel) addonToggles() map[string]types.Bool {
+	return map[string]types.Bool{
+		AttrClusterExoscaleCCM:   m.ExoscaleCCM,
+		AttrClusterExoscaleCSI:   m.ExoscaleCSI,
+		AttrClusterMetricsServer: m.MetricsServer,
+	}
+}
+
+// Metadata specifies resource name.
+func (r *ResourceCluster) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ClusterName
+}
+
+// Schema defines resource attributes.
+func (r *ResourceCluster) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: resourceClusterDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrClusterIDDescriptionResource,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterAddons: schema.SetAttribute{
+				ElementType:        types.StringType,
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: attrClusterAddonsDeprecation,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterAggregationCA: schema.StringAttribute{
+				MarkdownDescription: attrClusterAggregationCADescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterAutoUpgrade: schema.BoolAttribute{
+				MarkdownDescription: attrClusterAutoUpgradeDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			AttrClusterCNI: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrClusterCNIDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultClusterCNI),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrClusterControlPlaneCA: schema.StringAttribute{
+				MarkdownDescription: attrClusterControlPlaneCADescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrCreatedAt: schema.StringAttribute{
+				MarkdownDescription: attrClusterCreatedAtDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrDescription: schema.StringAttribute{
+				MarkdownDescription: attrClusterDescriptionDescription,
+				Optional:            true,
+			},
+			AttrClusterEndpoint: schema.StringAttribute{
+				MarkdownDescription: attrClusterEndpointDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterExoscaleCCM: schema.BoolAttribute{
+				MarkdownDescription: attrClusterExoscaleCCMDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			AttrClusterExoscaleCSI: schema.BoolAttribute{
+				MarkdownDescription: attrClusterExoscaleCSIDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			AttrClusterFailUpgrade: schema.BoolAttribute{
+				MarkdownDescription: attrClusterFailUpgradeDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			AttrClusterKubeletCA: schema.StringAttribute{
+				MarkdownDescription: attrClusterKubeletCADescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrLabels: schema.MapAttribute{
+				MarkdownDescription: attrLabelsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			AttrClusterMetricsServer: schema.BoolAttribute{
+				MarkdownDescription: attrClusterMetricsServerDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			AttrName: schema.StringAttribute{
+				MarkdownDescription: attrClusterNameDescription,
+				Required:            true,
+			},
+			AttrClusterNodepools: schema.SetAttribute{
+				MarkdownDescription: attrClusterNodepoolsDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			AttrClusterOIDC: schema.SingleNestedAttribute{
+				MarkdownDescription: attrClusterOIDCDescription,
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					AttrClusterOIDCClientID: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCClientIDDescription,
+						Required:            true,
+					},
+					AttrClusterOIDCGroupsClaim: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCGroupsClaimDescription,
+						Optional:            true,
+					},
+					AttrClusterOIDCGroupsPrefix: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCGroupsPrefixDescription,
+						Optional:            true,
+					},
+					AttrClusterOIDCIssuerURL: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCIssuerURLDescription,
+						Required:            true,
+					},
+					AttrClusterOIDCRequiredClaim: schema.MapAttribute{
+						MarkdownDescription: attrClusterOIDCRequiredClaimDescription,
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					AttrClusterOIDCUsernameClaim: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCUsernameClaimDescription,
+						Optional:            true,
+					},
+					AttrClusterOIDCUsernamePrefix: schema.StringAttribute{
+						MarkdownDescription: attrClusterOIDCUsernamePrefixDescription,
+						Optional:            true,
+					},
+				},
+			},
+			AttrClusterRotateCCMCreds: schema.StringAttribute{
+				MarkdownDescription: attrClusterRotateCCMCredsDescription,
+				Optional:            true,
+			},
+			AttrClusterRotateOperatorsCA: schema.StringAttribute{
+				MarkdownDescription: attrClusterRotateOperatorsCADescription,
+				Optional:            true,
+			},
+			AttrClusterServiceLevel: schema.StringAttribute{
+				MarkdownDescription: attrClusterServiceLevelDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultClusterServiceLevel),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(exoscale.SKSClusterLevelPro),
+						string(exoscale.SKSClusterLevelStarter),
+					),
+				},
+			},
+			AttrState: schema.StringAttribute{
+				MarkdownDescription: attrClusterStateDescription,
+				Computed:            true,
+			},
+			AttrVersion: schema.StringAttribute{
+				MarkdownDescription: attrClusterVersionDescription,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+		Version: 1,
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceCluster) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// ModifyPlan validates the planned changes which the API doesn't support, and
+// plans the values resulting from addons toggles and CA rotations.
+func (r *ResourceCluster) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, cfg ResourceClusterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The CSI relies on the CCM, the API refuses to enable the former without the latter.
+	if plan.ExoscaleCSI.ValueBool() && !plan.ExoscaleCCM.IsUnknown() && !plan.ExoscaleCCM.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterExoscaleCSI),
+			"invalid addons configuration",
+			fmt.Sprintf("%q requires %q to be enabled", AttrClusterExoscaleCSI, AttrClusterExoscaleCCM),
+		)
+		return
+	}
+
+	// The deprecated addons attribute can't contradict the toggles, since both are applied.
+	if !cfg.Addons.IsNull() && !cfg.Addons.IsUnknown() {
+		addons, diags := stringsFromSet(ctx, cfg.Addons)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		toggles := plan.addonToggles()
+		for _, t := range clusterAddonToggles {
+			if v := toggles[t.Attr]; !v.IsUnknown() && v.ValueBool() != slices.Contains(addons, t.Addon) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(AttrClusterAddons),
+					"invalid addons configuration",
+					fmt.Sprintf("the %q addon must be listed if and only if %q is enabled", t.Addon, t.Attr),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Nothing else to plan on creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ResourceClusterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catch deprecated resources removed by the target version early, when it is already known.
+	if !plan.Version.IsUnknown() && !plan.Version.Equal(state.Version) && plan.FailUpgrade.ValueBool() {
+		client, err := utils.SwitchClientZone(
+			ctx,
+			r.client,
+			exoscale.ZoneName(state.Zone.ValueString()),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to change exoscale client zone",
+				err.Error(),
+			)
+			return
+		}
+
+		if err := CheckDeprecatedResources(
+			ctx,
+			client,
+			exoscale.UUID(state.ID.ValueString()),
+			plan.Version.ValueString(),
+		); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(AttrVersion),
+				"unable to upgrade the cluster",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Unless set explicitly, the addons follow the changes of the toggles.
+	if cfg.Addons.IsNull() {
+		planToggles, stateToggles := plan.addonToggles(), state.addonToggles()
+		changes := map[string]bool{}
+		for _, t := range clusterAddonToggles {
+			if v := planToggles[t.Attr]; !v.IsUnknown() && !v.Equal(stateToggles[t.Attr]) {
+				changes[t.Addon] = v.ValueBool()
+			}
+		}
+
+		if len(changes) > 0 {
+			current, diags := stringsFromSet(ctx, state.Addons)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			plan.Addons, diags = types.SetValueFrom(ctx, types.StringType, clusterAddons(current, changes))
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(AttrClusterAddons), plan.Addons)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// An empty list of addons is omitted from update requests, so the last addon can't be disabled.
+	if !plan.Addons.IsUnknown() && !plan.Addons.Equal(state.Addons) && len(plan.Addons.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterAddons),
+			"invalid addons configuration",
+			"disabling all the addons of an existing cluster is not supported",
+		)
+		return
+	}
+
+	// The control plane can only be upgraded from starter to pro.
+	if state.ServiceLevel.ValueString() == string(exoscale.SKSClusterLevelPro) &&
+		plan.ServiceLevel.ValueString() == string(exoscale.SKSClusterLevelStarter) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterServiceLevel),
+			"invalid service level",
+			fmt.Sprintf(
+				"downgrading the service level of a cluster from %q to %q is not supported",
+				state.ServiceLevel.ValueString(),
+				plan.ServiceLevel.ValueString(),
+			),
+		)
+		return
+	}
+
+	// Rotating the operators CA issues a new control plane CA certificate.
+	if !plan.RotateOperatorsCA.Equal(state.RotateOperatorsCA) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(AttrClusterControlPlaneCA), types.StringUnknown())...)
+	}
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceClusterModel
+
+	// Load Terraform plan plan into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	request := exoscale.CreateSKSClusterRequest{
+		Cni:   exoscale.CreateSKSClusterRequestCni(plan.CNI.ValueString()),
+		Level: exoscale.CreateSKSClusterRequestLevel(plan.ServiceLevel.ValueString()),
+		Name:  plan.Name.ValueString(),
+	}
+
+	var addons []string
+	if !plan.Addons.IsUnknown() {
+		addons, diags = stringsFromSet(ctx, plan.Addons)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	planToggles, toggles := plan.addonToggles(), map[string]bool{}
+	for _, t := range clusterAddonToggles {
+		toggles[t.Addon] = planToggles[t.Attr].ValueBool()
+	}
+	if addons = clusterAddons(addons, toggles); len(addons) > 0 {
+		request.Addons = addons
+	}
+
+	if plan.AutoUpgrade.ValueBool() {
+		request.AutoUpgrade = plan.AutoUpgrade.ValueBoolPointer()
+	}
+
+	if plan.Description.ValueString() != "" {
+		request.Description = plan.Description.ValueStringPointer()
+	}
+
+	if len(plan.Labels.Elements()) > 0 {
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &request.Labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request.Oidc, diags = clusterOIDC(ctx, plan.OIDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Version = plan.Version.ValueString()
+	if request.Version == "" {
+		versions, err := client.ListSKSClusterVersions(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to list SKS cluster versions",
+				err.Error(),
+			)
+			return
+		}
+		if len(versions.SKSClusterVersions) == 0 {
+			resp.Diagnostics.AddError(
+				"unable to list SKS cluster versions",
+				"API returned empty list",
+			)
+			return
+		}
+
+		request.Version = versions.SKSClusterVersions[0]
+	}
+
+	op, err := client.CreateSKSCluster(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(op.Reference.ID.String())
+
+	// Update computed attributes before saving the state.
+	cluster, err := client.GetSKSCluster(ctx, exoscale.UUID(plan.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, cluster, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceCluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceClusterModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	cluster, err := client.GetSKSCluster(ctx, exoscale.UUID(state.ID.ValueString()))
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			// Resource doesn't exist anymore, signaling the core to remove it from the state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to get SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, cluster, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceClusterModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID := exoscale.UUID(state.ID.ValueString())
+
+	// First check if we need to upgrade the cluster.
+	if !plan.Version.IsUnknown() && !plan.Version.Equal(state.Version) {
+		if plan.FailUpgrade.ValueBool() {
+			if err := CheckDeprecatedResources(ctx, client, clusterID, plan.Version.ValueString()); err != nil {
+				resp.Diagnostics.AddError(
+					"unable to upgrade SKS cluster",
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if err := await(ctx, client)(client.UpgradeSKSCluster(ctx, clusterID, exoscale.UpgradeSKSClusterRequest{
+			Version: plan.Version.ValueString(),
+		})); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to upgrade SKS cluster",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.ServiceLevel.Equal(state.ServiceLevel) {
+		if err := await(ctx, client)(client.UpgradeSKSClusterServiceLevel(ctx, clusterID)); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to upgrade SKS cluster service level",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.RotateCCMCreds.Equal(state.RotateCCMCreds) {
+		if err := await(ctx, client)(client.RotateSKSCcmCredentials(ctx, clusterID)); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to rotate CCM credentials",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.RotateOperatorsCA.Equal(state.RotateOperatorsCA) {
+		if err := await(ctx, client)(client.RotateSKSOperatorsCA(ctx, clusterID)); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to rotate operators CA",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Reset the optional attributes which have been removed, the update request omitting empty values.
+	for _, reset := range []struct {
+		removed bool
+		field   exoscale.ResetSKSClusterFieldField
+	}{
+		{
+			removed: plan.Description.ValueString() == "" && state.Description.ValueString() != "",
+			field:   exoscale.ResetSKSClusterFieldFieldDescription,
+		},
+		{
+			removed: len(plan.Labels.Elements()) == 0 && len(state.Labels.Elements()) > 0,
+			field:   exoscale.ResetSKSClusterFieldFieldLabels,
+		},
+	} {
+		if !reset.removed {
+			continue
+		}
+
+		if err := await(ctx, client)(client.ResetSKSClusterField(ctx, clusterID, reset.field)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("unable to reset SKS cluster %s", reset.field),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	updated := false
+	request := exoscale.UpdateSKSClusterRequest{}
+
+	// The OIDC configuration is always part of the update request, which resets it otherwise:
+	// removing it from the configuration only requires an update without it.
+	request.Oidc, diags = clusterOIDC(ctx, plan.OIDC)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.OIDC.Equal(state.OIDC) {
+		updated = true
+	}
+
+	if !plan.AutoUpgrade.Equal(state.AutoUpgrade) {
+		request.AutoUpgrade = plan.AutoUpgrade.ValueBoolPointer()
+		updated = true
+	}
+
+	if !plan.Description.Equal(state.Description) && plan.Description.ValueString() != "" {
+		request.Description = plan.Description.ValueStringPointer()
+		updated = true
+	}
+
+	if !plan.Labels.Equal(state.Labels) && len(plan.Labels.Elements()) > 0 {
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &request.Labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updated = true
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.Name = plan.Name.ValueString()
+		updated = true
+	}
+
+	if updated {
+		if err := updateCluster(ctx, client, clusterID, request); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update SKS cluster",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Addons.IsUnknown() && !plan.Addons.Equal(state.Addons) {
+		addons, diags := stringsFromSet(ctx, plan.Addons)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateCluster(ctx, client, clusterID, exoscale.UpdateSKSClusterRequest{
+			Addons: addons,
+			Oidc:   request.Oidc,
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update SKS cluster addons",
+				fmt.Sprintf("unable to update the cluster addons from %v to %v: %s", state.Addons, addons, err),
+			)
+			return
+		}
+	}
+
+	// Update computed attributes before saving the state.
+	cluster, err := client.GetSKSCluster(ctx, exoscale.UUID(plan.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, cluster, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceCluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceClusterModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := await(ctx, client)(client.DeleteSKSCluster(ctx, exoscale.UUID(state.ID.ValueString()))); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to delete SKS cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceCluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceClusterModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ID = types.StringValue(idParts[0])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Set values which can't be read from the API.
+	state.FailUpgrade = types.BoolValue(false)
+
+	// Set null values
+	state.Addons = types.SetNull(types.StringType)
+	state.Labels = types.MapNull(types.StringType)
+	state.Nodepools = types.SetNull(types.StringType)
+	state.OIDC = types.ObjectNull(ClusterOIDCModel{}.Types())
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// UpgradeState migrates the state of resources managed by the former SDKv2 implementation.
+func (r *ResourceCluster) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// SDKv2 to Framework migration requires state upgrade of the oidc block
+		// to remove the array from it.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					AttrID:                       schema.StringAttribute{Computed: true},
+					AttrClusterAddons:            schema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					AttrClusterAggregationCA:     schema.StringAttribute{Computed: true},
+					AttrClusterAutoUpgrade:       schema.BoolAttribute{Optional: true},
+					AttrClusterCNI:               schema.StringAttribute{Optional: true},
+					AttrClusterControlPlaneCA:    schema.StringAttribute{Computed: true},
+					AttrCreatedAt:                schema.StringAttribute{Computed: true},
+					AttrDescription:              schema.StringAttribute{Optional: true},
+					AttrClusterEndpoint:          schema.StringAttribute{Computed: true},
+					AttrClusterExoscaleCCM:       schema.BoolAttribute{Optional: true},
+					AttrClusterExoscaleCSI:       schema.BoolAttribute{Optional: true},
+					AttrClusterFailUpgrade:       schema.BoolAttribute{Optional: true},
+					AttrClusterKubeletCA:         schema.StringAttribute{Computed: true},
+					AttrLabels:                   schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					AttrClusterMetricsServer:     schema.BoolAttribute{Optional: true},
+					AttrName:                     schema.StringAttribute{Required: true},
+					AttrClusterNodepools:         schema.SetAttribute{ElementType: types.StringType, Computed: true},
+					AttrClusterRotateCCMCreds:    schema.StringAttribute{Optional: true},
+					AttrClusterRotateOperatorsCA: schema.StringAttribute{Optional: true},
+					AttrClusterServiceLevel:      schema.StringAttribute{Optional: true},
+					AttrState:                    schema.StringAttribute{Computed: true},
+					AttrVersion:                  schema.StringAttribute{Optional: true, Computed: true},
+					AttrZone:                     schema.StringAttribute{Required: true},
+				},
+				Blocks: map[string]schema.Block{
+					AttrClusterOIDC: schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								AttrClusterOIDCClientID:       schema.StringAttribute{Required: true},
+								AttrClusterOIDCGroupsClaim:    schema.StringAttribute{Optional: true},
+								AttrClusterOIDCGroupsPrefix:   schema.StringAttribute{Optional: true},
+								AttrClusterOIDCIssuerURL:      schema.StringAttribute{Required: true},
+								AttrClusterOIDCRequiredClaim:  schema.MapAttribute{ElementType: types.StringType, Optional: true},
+								AttrClusterOIDCUsernameClaim:  schema.StringAttribute{Optional: true},
+								AttrClusterOIDCUsernamePrefix: schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"timeouts": timeouts.BlockAll(ctx),
+				},
+			},
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				priorState := struct {
+					ID                types.String       `tfsdk:"id"`
+					Addons            types.Set          `tfsdk:"addons"`
+					AggregationCA     types.String       `tfsdk:"aggregation_ca"`
+					AutoUpgrade       types.Bool         `tfsdk:"auto_upgrade"`
+					CNI               types.String       `tfsdk:"cni"`
+					ControlPlaneCA    types.String       `tfsdk:"control_plane_ca"`
+					CreatedAt         types.String       `tfsdk:"created_at"`
+					Description       types.String       `tfsdk:"description"`
+					Endpoint          types.String       `tfsdk:"endpoint"`
+					ExoscaleCCM       types.Bool         `tfsdk:"exoscale_ccm"`
+					ExoscaleCSI       types.Bool         `tfsdk:"exoscale_csi"`
+					FailUpgrade       types.Bool         `tfsdk:"fail_upgrade_on_deprecated_resources"`
+					KubeletCA         types.String       `tfsdk:"kubelet_ca"`
+					Labels            types.Map          `tfsdk:"labels"`
+					MetricsServer     types.Bool         `tfsdk:"metrics_server"`
+					Name              types.String       `tfsdk:"name"`
+					Nodepools         types.Set          `tfsdk:"nodepools"`
+					OIDC              []ClusterOIDCModel `tfsdk:"oidc"`
+					RotateCCMCreds    types.String       `tfsdk:"rotate_ccm_credentials_trigger"`
+					RotateOperatorsCA types.String       `tfsdk:"rotate_operators_ca_trigger"`
+					ServiceLevel      types.String       `tfsdk:"service_level"`
+					State             types.String       `tfsdk:"state"`
+					Version           types.String       `tfsdk:"version"`
+					Zone              types.String       `tfsdk:"zone"`
+					Timeouts          timeouts.Value     `tfsdk:"timeouts"`
+				}{}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedStateData := ResourceClusterModel{
+					ID:                priorState.ID,
+					Addons:            priorState.Addons,
+					AggregationCA:     priorState.AggregationCA,
+					AutoUpgrade:       priorState.AutoUpgrade,
+					CNI:               priorState.CNI,
+					ControlPlaneCA:    priorState.ControlPlaneCA,
+					CreatedAt:         priorState.CreatedAt,
+					Description:       priorState.Description,
+					Endpoint:          priorState.Endpoint,
+					ExoscaleCCM:       priorState.ExoscaleCCM,
+					ExoscaleCSI:       priorState.ExoscaleCSI,
+					FailUpgrade:       priorState.FailUpgrade,
+					KubeletCA:         priorState.KubeletCA,
+					Labels:            priorState.Labels,
+					MetricsServer:     priorState.MetricsServer,
+					Name:              priorState.Name,
+					Nodepools:         priorState.Nodepools,
+					RotateCCMCreds:    priorState.RotateCCMCreds,
+					RotateOperatorsCA: priorState.RotateOperatorsCA,
+					ServiceLevel:      priorState.ServiceLevel,
+					State:             priorState.State,
+					Version:           priorState.Version,
+					Zone:              priorState.Zone,
+					Timeouts:          priorState.Timeouts,
+				}
+
+				// SDKv2 stores unset optional values as empty ones.
+				upgradedStateData.Description = nullIfEmptyString(upgradedStateData.Description)
+				upgradedStateData.Labels = nullIfEmptyMap(upgradedStateData.Labels)
+				upgradedStateData.RotateCCMCreds = nullIfEmptyString(upgradedStateData.RotateCCMCreds)
+				upgradedStateData.RotateOperatorsCA = nullIfEmptyString(upgradedStateData.RotateOperatorsCA)
+				if upgradedStateData.AutoUpgrade.IsNull() {
+					upgradedStateData.AutoUpgrade = types.BoolValue(false)
+				}
+				if upgradedStateData.FailUpgrade.IsNull() {
+					upgradedStateData.FailUpgrade = types.BoolValue(false)
+				}
+
+				upgradedStateData.OIDC = types.ObjectNull(ClusterOIDCModel{}.Types())
+				if len(priorState.OIDC) > 0 {
+					oidc := priorState.OIDC[0]
+					oidc.GroupsClaim = nullIfEmptyString(oidc.GroupsClaim)
+					oidc.GroupsPrefix = nullIfEmptyString(oidc.GroupsPrefix)
+					oidc.RequiredClaim = nullIfEmptyMap(oidc.RequiredClaim)
+					oidc.UsernameClaim = nullIfEmptyString(oidc.UsernameClaim)
+					oidc.UsernamePrefix = nullIfEmptyString(oidc.UsernamePrefix)
+
+					var diags diag.Diagnostics
+					upgradedStateData.OIDC, diags = types.ObjectValueFrom(ctx, ClusterOIDCModel{}.Types(), oidc)
+					resp.Diagnostics.Append(diags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+			},
+		},
+	}
+}
+
+// apply updates the model with the cluster values from the API.
+func (r *ResourceCluster) apply(
+	ctx context.Context,
+	client *exoscale.Client,
+	cluster *exoscale.SKSCluster,
+	m *ResourceClusterModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	certificates, err := readClusterCertificates(ctx, client, cluster.ID)
+	if err != nil {
+		diags.AddError(
+			"unable to get SKS cluster certificates",
+			err.Error(),
+		)
+		return diags
+	}
+
+	addons := cluster.Addons
+	if addons == nil {
+		addons = []string{}
+	}
+	m.Addons, diags = types.SetValueFrom(ctx, types.StringType, addons)
+	if diags.HasError() {
+		return diags
+	}
+	m.ExoscaleCCM = types.BoolValue(slices.Contains(addons, clusterAddonExoscaleCCM))
+	m.ExoscaleCSI = types.BoolValue(slices.Contains(addons, clusterAddonExoscaleCSI))
+	m.MetricsServer = types.BoolValue(slices.Contains(addons, clusterAddonMetricsServer))
+
+	nodepools := make([]string, len(cluster.Nodepools))
+	for i, nodepool := range cluster.Nodepools {
+		nodepools[i] = nodepool.ID.String()
+	}
+	m.Nodepools, diags = types.SetValueFrom(ctx, types.StringType, nodepools)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Keep unset optional values null rather than empty.
+	if !m.Labels.IsNull() || len(cluster.Labels) > 0 {
+		m.Labels, diags = types.MapValueFrom(ctx, types.StringType, map[string]string(cluster.Labels))
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if !m.Description.IsNull() || cluster.Description != "" {
+		m.Description = types.StringValue(cluster.Description)
+	}
+
+	m.AggregationCA = types.StringValue(certificates.AggregationCA)
+	m.AutoUpgrade = types.BoolValue(cluster.AutoUpgrade != nil && *cluster.AutoUpgrade)
+	m.CNI = types.StringValue(string(cluster.Cni))
+	m.ControlPlaneCA = types.StringValue(certificates.ControlPlaneCA)
+	m.CreatedAt = types.StringValue(cluster.CreatedAT.String())
+	m.Endpoint = types.StringValue(cluster.Endpoint)
+	m.KubeletCA = types.StringValue(certificates.KubeletCA)
+	m.Name = types.StringValue(cluster.Name)
+	m.ServiceLevel = types.StringValue(string(cluster.Level))
+	m.State = types.StringValue(string(cluster.State))
+	m.Version = types.StringValue(cluster.Version)
+
+	return diags
+}
+
+// clusterOIDC returns the API OIDC configuration of the "oidc" attribute, or nil if unset.
+func clusterOIDC(ctx context.Context, v types.Object) (*exoscale.SKSOidc, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	var m ClusterOIDCModel
+	diags := v.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	oidc := &exoscale.SKSOidc{
+		ClientID:       m.ClientID.ValueString(),
+		GroupsClaim:    m.GroupsClaim.ValueString(),
+		GroupsPrefix:   m.GroupsPrefix.ValueString(),
+		IssuerURL:      m.IssuerURL.ValueString(),
+		UsernameClaim:  m.UsernameClaim.ValueString(),
+		UsernamePrefix: m.UsernamePrefix.ValueString(),
+	}
+
+	if len(m.RequiredClaim.Elements()) > 0 {
+		diags.Append(m.RequiredClaim.ElementsAs(ctx, &oidc.RequiredClaim, false)...)
+	}
+
+	return oidc, diags
+}
@@ -0,0 +1,254 @@
+package sks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sks"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+var testPemCertificateRegexp = regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)
+
+func testResourceCluster(t *testing.T) {
+	resourceName := "exoscale_sks_cluster.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	var clusterID, controlPlaneCA string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckClusterDestroy(testdataSpec.Zone, &clusterID),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: testutils.ParseTestdataConfig("./testdata/006.cluster_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckClusterAddons(resourceName, testdataSpec.Zone, &clusterID, "exoscale-cloud-controller"),
+					func(s *terraform.State) (err error) {
+						controlPlaneCA, err = testutils.AttrFromState(s, resourceName, sks.AttrClusterControlPlaneCA)
+						return err
+					},
+					resource.TestCheckResourceAttr(resourceName, sks.AttrName, fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrDescription, "test-description"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrLabels+".test", fmt.Sprintf("test-%d", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterAutoUpgrade, "true"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterCNI, "calico"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterExoscaleCCM, "true"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterExoscaleCSI, "false"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterMetricsServer, "false"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterServiceLevel, "pro"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterOIDC+".client_id", "client-id"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterOIDC+".required_claim.test", "required-claim"),
+					resource.TestMatchResourceAttr(resourceName, sks.AttrClusterAggregationCA, testPemCertificateRegexp),
+					resource.TestMatchResourceAttr(resourceName, sks.AttrClusterControlPlaneCA, testPemCertificateRegexp),
+					resource.TestMatchResourceAttr(resourceName, sks.AttrClusterKubeletCA, testPemCertificateRegexp),
+					resource.TestMatchResourceAttr(resourceName, sks.AttrClusterEndpoint, regexp.MustCompile(`^https://`)),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrState),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrVersion),
+				),
+			},
+			{
+				// Update
+				Config: testutils.ParseTestdataConfig("./testdata/007.cluster_update.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckClusterAddons(
+						resourceName,
+						testdataSpec.Zone,
+						&clusterID,
+						"exoscale-cloud-controller",
+						"exoscale-container-storage-interface",
+						"metrics-server",
+					),
+					func(s *terraform.State) error {
+						ca, err := testutils.AttrFromState(s, resourceName, sks.AttrClusterControlPlaneCA)
+						if err != nil {
+							return err
+						}
+						if ca == controlPlaneCA {
+							return errors.New("control plane CA has not been rotated")
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, sks.AttrName, fmt.Sprintf("terraform-provider-test-%d-updated", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrDescription, "test-description-updated"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrLabels+".test", fmt.Sprintf("test-%d-updated", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterExoscaleCSI, "true"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterMetricsServer, "true"),
+					resource.TestCheckNoResourceAttr(resourceName, sks.AttrClusterOIDC),
+					resource.TestMatchResourceAttr(resourceName, sks.AttrClusterControlPlaneCA, testPemCertificateRegexp),
+				),
+			},
+			{
+				// Import
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s@%s", clusterID, testdataSpec.Zone), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					sks.AttrClusterFailUpgrade,
+					sks.AttrClusterRotateOperatorsCA,
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+func testResourceClusterServiceLevel(t *testing.T) {
+	resourceName := "exoscale_sks_cluster.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	var clusterID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckClusterDestroy(testdataSpec.Zone, &clusterID),
+		Steps: []resource.TestStep{
+			{
+				// Create starter cluster
+				Config: testutils.ParseTestdataConfig("./testdata/011.cluster_service_level_starter.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckClusterServiceLevel(resourceName, testdataSpec.Zone, &clusterID, "starter"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterServiceLevel, "starter"),
+				),
+			},
+			{
+				// Upgrade to pro in place
+				Config: testutils.ParseTestdataConfig("./testdata/012.cluster_service_level_pro.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckClusterServiceLevel(resourceName, testdataSpec.Zone, &clusterID, "pro"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrClusterServiceLevel, "pro"),
+					func(s *terraform.State) error {
+						id, err := testutils.AttrFromState(s, resourceName, sks.AttrID)
+						if err != nil {
+							return err
+						}
+						if id != clusterID {
+							return errors.New("cluster has been replaced")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Downgrade to starter is rejected at plan time
+				Config:      testutils.ParseTestdataConfig("./testdata/011.cluster_service_level_starter.tf.tmpl", &testdataSpec),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("downgrading the service level"),
+			},
+		},
+	})
+}
+
+// testGetCluster returns the cluster reported by the API, and stores its ID into clusterID.
+func testGetCluster(s *terraform.State, resourceName, zone string, clusterID *string) (*exoscale.SKSCluster, error) {
+	id, err := testutils.AttrFromState(s, resourceName, sks.AttrID)
+	if err != nil {
+		return nil, err
+	}
+	*clusterID = id
+
+	client, err := testutils.APIClientV3()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	client, err = utils.SwitchClientZone(ctx, client, exoscale.ZoneName(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetSKSCluster(ctx, exoscale.UUID(id))
+}
+
+// testCheckClusterAddons checks the addons of the cluster reported by the API.
+func testCheckClusterAddons(resourceName, zone string, clusterID *string, addons ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cluster, err := testGetCluster(s, resourceName, zone, clusterID)
+		if err != nil {
+			return err
+		}
+
+		got := slices.Clone(cluster.Addons)
+		slices.Sort(got)
+		if !slices.Equal(addons, got) {
+			return fmt.Errorf("expected cluster addons %v, got %v", addons, got)
+		}
+
+		return nil
+	}
+}
+
+// testCheckClusterServiceLevel checks the service level of the cluster reported by the API.
+func testCheckClusterServiceLevel(resourceName, zone string, clusterID *string, level string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cluster, err := testGetCluster(s, resourceName, zone, clusterID)
+		if err != nil {
+			return err
+		}
+
+		if string(cluster.Level) != level {
+			return fmt.Errorf("expected cluster service level %q, got %q", level, cluster.Level)
+		}
+
+		return nil
+	}
+}
+
+// testCheckClusterDestroy checks that the cluster has been deleted.
+func testCheckClusterDestroy(zone string, clusterID *string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := testutils.APIClientV3()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		client, err = utils.SwitchClientZone(ctx, client, exoscale.ZoneName(zone))
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.GetSKSCluster(ctx, exoscale.UUID(*clusterID)); err != nil {
+			if errors.Is(err, exoscale.ErrNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		return errors.New("SKS cluster still exists")
+	}
+}
@@ -0,0 +1,418 @@
+package sks
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+)
+
+const resourceKubeconfigDescription = "Manage Exoscale Scalable Kubernetes Service (SKS) Credentials (Kubeconfig)."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceKubeconfig{}
+var _ resource.ResourceWithModifyPlan = &ResourceKubeconfig{}
+
+// ResourceKubeconfig defines the resource implementation.
+type ResourceKubeconfig struct {
+	client *exoscale.Client
+}
+
+// NewResourceKubeconfig creates instance of ResourceKubeconfig.
+func NewResourceKubeconfig() resource.Resource {
+	return &ResourceKubeconfig{}
+}
+
+// ResourceKubeconfigModel defines the resource data model.
+type ResourceKubeconfigModel struct {
+	ID                   types.String  `tfsdk:"id"`
+	ClientCertificate    types.String  `tfsdk:"client_certificate"`
+	ClientKey            types.String  `tfsdk:"client_key"`
+	ClusterCACertificate types.String  `tfsdk:"cluster_ca_certificate"`
+	ClusterID            types.String  `tfsdk:"cluster_id"`
+	EarlyRenewalSeconds  types.Int64   `tfsdk:"early_renewal_seconds"`
+	ExpiresAt            types.String  `tfsdk:"expires_at"`
+	Groups               types.Set     `tfsdk:"groups"`
+	Host                 types.String  `tfsdk:"host"`
+	Kubeconfig           types.String  `tfsdk:"kubeconfig"`
+	ReadyForRenewal      types.Bool    `tfsdk:"ready_for_renewal"`
+	TTLSeconds           types.Float64 `tfsdk:"ttl_seconds"`
+	User                 types.String  `tfsdk:"user"`
+	Zone                 types.String  `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceKubeconfig) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = KubeconfigName
+}
+
+// Schema defines resource attributes.
+func (r *ResourceKubeconfig) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: resourceKubeconfigDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrKubeconfigIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClientCertificate: schema.StringAttribute{
+				MarkdownDescription: attrClientCertificateDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClientKey: schema.StringAttribute{
+				MarkdownDescription: attrClientKeyDescription,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterCACertificate: schema.StringAttribute{
+				MarkdownDescription: attrClusterCACertificateDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrClusterID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrClusterIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrEarlyRenewalSeconds: schema.Int64Attribute{
+				MarkdownDescription: attrEarlyRenewalSecondsDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			AttrExpiresAt: schema.StringAttribute{
+				MarkdownDescription: attrKubeconfigExpiresAtDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrGroups: schema.SetAttribute{
+				MarkdownDescription: "❗ " + attrGroupsDescription,
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrHost: schema.StringAttribute{
+				MarkdownDescription: attrHostDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrKubeconfig: schema.StringAttribute{
+				MarkdownDescription: attrKubeconfigDescription,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrReadyForRenewal: schema.BoolAttribute{
+				MarkdownDescription: attrReadyForRenewalDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrTTLSeconds: schema.Float64Attribute{
+				MarkdownDescription: "❗ " + attrTTLSecondsDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(30 * 24 * 3600),
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			AttrUser: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrUserDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceKubeconfig) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+}
+
+// ModifyPlan plans the replacement of the Kubeconfig once it is due for renewal.
+func (r *ResourceKubeconfig) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew on creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ResourceKubeconfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.EarlyRenewalSeconds.IsUnknown() {
+		return
+	}
+
+	readyForRenewal := state.Kubeconfig.ValueString() == ""
+	if !readyForRenewal {
+		k, err := parseKubeconfig(state.Kubeconfig.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(AttrKubeconfig),
+				"unable to parse kubeconfig",
+				err.Error(),
+			)
+			return
+		}
+
+		readyForRenewal = k.ReadyForRenewal(
+			time.Duration(plan.EarlyRenewalSeconds.ValueInt64())*time.Second,
+			time.Now(),
+		)
+	}
+
+	if readyForRenewal {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(AttrReadyForRenewal), true)...)
+		resp.RequiresReplace.Append(path.Root(AttrReadyForRenewal))
+	}
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceKubeconfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceKubeconfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID, err := exoscale.ParseUUID(plan.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(AttrClusterID),
+			"unable to parse cluster ID",
+			err.Error(),
+		)
+		return
+	}
+
+	groups := []string{}
+	resp.Diagnostics.Append(plan.Groups.ElementsAs(ctx, &groups, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := client.GenerateSKSClusterKubeconfig(ctx, clusterID, exoscale.SKSKubeconfigRequest{
+		Groups: groups,
+		Ttl:    int64(plan.TTLSeconds.ValueFloat64()),
+		User:   plan.User.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to generate kubeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	kubeconfig, err := base64.StdEncoding.DecodeString(res.Kubeconfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to decode kubeconfig content",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Kubeconfig = types.StringValue(string(kubeconfig))
+	plan.ReadyForRenewal = types.BoolValue(false)
+
+	if err := setKubeconfigValues(&plan); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to parse kubeconfig",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceKubeconfig) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceKubeconfigModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No revocation: the Kubeconfig stays valid until its certificates expire,
+	// so there is nothing to refresh from the API. The structured values are
+	// (re-)derived from the Kubeconfig content, which also fills them in for
+	// resources created by former versions of the provider.
+	if state.Kubeconfig.ValueString() != "" {
+		if err := setKubeconfigValues(&state); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to parse kubeconfig",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if state.ReadyForRenewal.IsNull() {
+		state.ReadyForRenewal = types.BoolValue(false)
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceKubeconfig) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceKubeconfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only early_renewal_seconds (and timeouts) may be updated in-place,
+	// which doesn't involve any API call.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource updated", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceKubeconfig) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceKubeconfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No revocation: we rely on client certificate expiration,
+	// so let's just remove the kubeconfig from the state.
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// setKubeconfigValues derives the computed attributes of the model from its Kubeconfig content.
+func setKubeconfigValues(m *ResourceKubeconfigModel) error {
+	k, err := parseKubeconfig(m.Kubeconfig.ValueString())
+	if err != nil {
+		return err
+	}
+
+	m.ID = types.StringValue(k.ID())
+	m.ClientCertificate = types.StringValue(k.ClientCertificate)
+	m.ClientKey = types.StringValue(k.ClientKey)
+	m.ClusterCACertificate = types.StringValue(k.ClusterCACertificate)
+	m.ExpiresAt = types.StringValue(k.ExpiresAt().Format(time.RFC3339))
+	m.Host = types.StringValue(k.Host)
+
+	return nil
+}
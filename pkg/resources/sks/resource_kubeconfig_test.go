@@ -0,0 +1,73 @@
+package sks_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sks"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testResourceKubeconfig(t *testing.T) {
+	resourceName := "exoscale_sks_kubeconfig.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.ParseTestdataConfig("./testdata/005.kubeconfig.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						kubeconfig, err := testutils.AttrFromState(s, resourceName, sks.AttrKubeconfig)
+						if err != nil {
+							return err
+						}
+
+						_, certificates, err := sks.KubeconfigExtractCertificates(kubeconfig)
+						if err != nil {
+							return err
+						}
+						if len(certificates) != 1 {
+							return errors.New("expected exactly one client certificate")
+						}
+
+						a := require.New(t)
+						clientCertificate := *(certificates[0])
+						certificateTTL := int64(clientCertificate.NotAfter.Sub(clientCertificate.NotBefore).Seconds())
+
+						a.InDelta(3600, certificateTTL, 10)
+						a.Equal("kube-user", clientCertificate.Subject.CommonName)
+						a.Equal("kube-group", clientCertificate.Subject.Organization[0])
+
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "groups.0", "kube-group"),
+					resource.TestCheckResourceAttr(resourceName, "ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "user", "kube-user"),
+					resource.TestCheckResourceAttr(resourceName, "early_renewal_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "ready_for_renewal", "false"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "cluster_ca_certificate",
+						"exoscale_sks_cluster.test", "control_plane_ca",
+					),
+					resource.TestCheckResourceAttrSet(resourceName, "host"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+		},
+	})
+}
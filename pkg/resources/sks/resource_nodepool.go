@@ -0,0 +1,1293 @@
+package sks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	exoscale "github.com/exoscale/egoscale/v3"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/config"
+	providerConfig "github.com/exoscale/terraform-provider-exoscale/pkg/provider/config"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/instance_type"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/utils"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/validators"
+)
+
+const resourceNodepoolDescription = "Manage Exoscale [Scalable Kubernetes Service (SKS)](https://community.exoscale.com/documentation/sks/) Node Pools."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResourceNodepool{}
+var _ resource.ResourceWithImportState = &ResourceNodepool{}
+var _ resource.ResourceWithModifyPlan = &ResourceNodepool{}
+var _ resource.ResourceWithUpgradeState = &ResourceNodepool{}
+
+// ResourceNodepool defines the resource implementation.
+type ResourceNodepool struct {
+	client *exoscale.Client
+	env    string
+}
+
+// NewResourceNodepool creates instance of ResourceNodepool.
+func NewResourceNodepool() resource.Resource {
+	return &ResourceNodepool{}
+}
+
+// ResourceNodepoolModel defines the resource data model.
+type ResourceNodepoolModel struct {
+	ID                   types.String `tfsdk:"id"`
+	AntiAffinityGroupIDs types.Set    `tfsdk:"anti_affinity_group_ids"`
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	DeployTargetID       types.String `tfsdk:"deploy_target_id"`
+	Description          types.String `tfsdk:"description"`
+	DiskSize             types.Int64  `tfsdk:"disk_size"`
+	InstancePoolID       types.String `tfsdk:"instance_pool_id"`
+	InstancePrefix       types.String `tfsdk:"instance_prefix"`
+	InstanceType         types.String `tfsdk:"instance_type"`
+	KubeletImageGC       types.Object `tfsdk:"kubelet_image_gc"`
+	Labels               types.Map    `tfsdk:"labels"`
+	Name                 types.String `tfsdk:"name"`
+	NodeUpgrade          types.Object `tfsdk:"node_upgrade"`
+	PrivateNetworkIDs    types.Set    `tfsdk:"private_network_ids"`
+	SecurityGroupIDs     types.Set    `tfsdk:"security_group_ids"`
+	Size                 types.Int64  `tfsdk:"size"`
+	State                types.String `tfsdk:"state"`
+	StorageLVM           types.Bool   `tfsdk:"storage_lvm"`
+	Taints               types.Map    `tfsdk:"taints"`
+	TemplateID           types.String `tfsdk:"template_id"`
+	Version              types.String `tfsdk:"version"`
+	Zone                 types.String `tfsdk:"zone"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata specifies resource name.
+func (r *ResourceNodepool) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = NodepoolName
+}
+
+// Schema defines resource attributes.
+func (r *ResourceNodepool) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: resourceNodepoolDescription,
+		Attributes: map[string]schema.Attribute{
+			AttrID: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrNodepoolAntiAffinityGroupIDs: schema.SetAttribute{
+				MarkdownDescription: attrNodepoolAntiAffinityGroupIDsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			AttrClusterID: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrNodepoolClusterIDDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrCreatedAt: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolCreatedAtDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrNodepoolDeployTargetID: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolDeployTargetIDDescription,
+				Optional:            true,
+			},
+			AttrDescription: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolDescriptionDescription,
+				Optional:            true,
+			},
+			AttrNodepoolDiskSize: schema.Int64Attribute{
+				MarkdownDescription: attrNodepoolDiskSizeDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultNodepoolDiskSize),
+			},
+			AttrNodepoolInstancePoolID: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolInstancePoolIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrNodepoolInstancePrefix: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolInstancePrefixDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultNodepoolInstancePrefix),
+			},
+			AttrNodepoolInstanceType: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolInstanceTypeDescription,
+				Required:            true,
+			},
+			AttrNodepoolKubeletImageGC: schema.SingleNestedAttribute{
+				MarkdownDescription: attrNodepoolKubeletImageGCDescription,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					AttrNodepoolKubeletImageGCHighThreshold: schema.Int64Attribute{
+						MarkdownDescription: "❗ " + attrNodepoolKubeletImageGCHighThresholdDescription,
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					AttrNodepoolKubeletImageGCLowThreshold: schema.Int64Attribute{
+						MarkdownDescription: "❗ " + attrNodepoolKubeletImageGCLowThresholdDescription,
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					AttrNodepoolKubeletImageGCMinAge: schema.StringAttribute{
+						MarkdownDescription: "❗ " + attrNodepoolKubeletImageGCMinAgeDescription,
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			AttrLabels: schema.MapAttribute{
+				MarkdownDescription: attrLabelsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			AttrName: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolNameDescription,
+				Required:            true,
+			},
+			AttrNodepoolNodeUpgrade: schema.SingleNestedAttribute{
+				MarkdownDescription: attrNodepoolNodeUpgradeDescription,
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					AttrNodepoolNodeUpgradeBatchSize: schema.Int64Attribute{
+						MarkdownDescription: attrNodepoolNodeUpgradeBatchSizeDescription,
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(defaultNodepoolBatchSize),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					AttrNodepoolNodeUpgradeClusterVersion: schema.StringAttribute{
+						MarkdownDescription: attrNodepoolNodeUpgradeClusterVersionDescription,
+						Optional:            true,
+					},
+					AttrNodepoolNodeUpgradePauseBetweenBatches: schema.StringAttribute{
+						MarkdownDescription: attrNodepoolNodeUpgradePauseBetweenBatchesDescription,
+						Optional:            true,
+						Validators: []validator.String{
+							validators.IsDurationValidator{},
+						},
+					},
+				},
+			},
+			AttrNodepoolPrivateNetworkIDs: schema.SetAttribute{
+				MarkdownDescription: attrNodepoolPrivateNetworkIDsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			AttrNodepoolSecurityGroupIDs: schema.SetAttribute{
+				MarkdownDescription: attrNodepoolSecurityGroupIDsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			AttrNodepoolSize: schema.Int64Attribute{
+				MarkdownDescription: attrNodepoolSizeDescription,
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			AttrState: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolStateDescription,
+				Computed:            true,
+			},
+			AttrNodepoolStorageLVM: schema.BoolAttribute{
+				MarkdownDescription: "❗ " + attrNodepoolStorageLVMDescription,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			AttrNodepoolTaints: schema.MapAttribute{
+				MarkdownDescription: attrNodepoolTaintsDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							nodepoolTaintRegexp,
+							"must be formatted as VALUE:EFFECT, EFFECT being one of NoSchedule, PreferNoSchedule or NoExecute",
+						),
+					),
+				},
+			},
+			AttrNodepoolTemplateID: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolTemplateIDDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrVersion: schema.StringAttribute{
+				MarkdownDescription: attrNodepoolVersionDescription,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			AttrZone: schema.StringAttribute{
+				MarkdownDescription: "❗ " + attrZoneDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(config.Zones...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+		Version: 1,
+	}
+}
+
+// Configure sets up resource dependencies.
+func (r *ResourceNodepool) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).ClientV3
+	r.env = req.ProviderData.(*providerConfig.ExoscaleProviderConfig).Environment
+}
+
+// ModifyPlan validates the instance type against the zone catalogue, and plans
+// the cycling of outdated nodes.
+func (r *ResourceNodepool) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceNodepoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Zone.IsUnknown() {
+		return
+	}
+
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.InstanceType.IsUnknown() && !plan.InstanceType.Equal(state.InstanceType) {
+		if err := instance_type.ValidateNameWithClient(
+			ctx,
+			client,
+			r.env,
+			plan.Zone.ValueString(),
+			plan.InstanceType.ValueString(),
+		); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(AttrNodepoolInstanceType),
+				"invalid instance type",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// The nodes are only cycled by updates.
+	if req.State.Raw.IsNull() || plan.NodeUpgrade.IsNull() || plan.NodeUpgrade.IsUnknown() {
+		return
+	}
+
+	var planUpgrade, stateUpgrade NodepoolNodeUpgradeModel
+	resp.Diagnostics.Append(plan.NodeUpgrade.As(ctx, &planUpgrade, basetypes.ObjectAsOptions{})...)
+	if !state.NodeUpgrade.IsNull() {
+		resp.Diagnostics.Append(state.NodeUpgrade.As(ctx, &stateUpgrade, basetypes.ObjectAsOptions{})...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The nodes are cycled by the update following a change of the parent cluster version,
+	// or if some of them still run the template of a previous version.
+	outdated := planUpgrade.ClusterVersion.IsUnknown() ||
+		(!planUpgrade.ClusterVersion.IsNull() && !planUpgrade.ClusterVersion.Equal(stateUpgrade.ClusterVersion))
+
+	if !outdated {
+		nodepool, err := client.GetSKSNodepool(
+			ctx,
+			exoscale.UUID(state.ClusterID.ValueString()),
+			exoscale.UUID(state.ID.ValueString()),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to get SKS nodepool",
+				err.Error(),
+			)
+			return
+		}
+
+		nodes, err := nodepoolOutdatedNodes(ctx, client, nodepool)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to get SKS nodepool nodes",
+				err.Error(),
+			)
+			return
+		}
+		outdated = len(nodes) > 0
+	}
+
+	if outdated {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(AttrVersion), types.StringUnknown())...)
+	}
+}
+
+// Create resources by receiving Terraform configuration and plan data, performing creation logic, and saving Terraform state data.
+func (r *ResourceNodepool) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ResourceNodepoolModel
+
+	// Load Terraform plan plan into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Create(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	instanceType, err := utils.FindInstanceTypeByNameV3(ctx, client, plan.InstanceType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to retrieve instance type",
+			err.Error(),
+		)
+		return
+	}
+
+	request := exoscale.CreateSKSNodepoolRequest{
+		Description:    plan.Description.ValueString(),
+		DiskSize:       plan.DiskSize.ValueInt64(),
+		InstancePrefix: plan.InstancePrefix.ValueString(),
+		InstanceType:   &exoscale.InstanceType{ID: instanceType.ID},
+		Name:           plan.Name.ValueString(),
+		Size:           plan.Size.ValueInt64(),
+	}
+
+	if plan.StorageLVM.ValueBool() {
+		request.Addons = []string{nodepoolAddonStorageLVM}
+	}
+
+	if v := plan.DeployTargetID.ValueString(); v != "" {
+		request.DeployTarget = &exoscale.DeployTarget{ID: exoscale.UUID(v)}
+	}
+
+	ids, diags := nodepoolResourceIDs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request.AntiAffinityGroups = ids.antiAffinityGroups
+	request.PrivateNetworks = ids.privateNetworks
+	request.SecurityGroups = ids.securityGroups
+
+	if !plan.KubeletImageGC.IsNull() && !plan.KubeletImageGC.IsUnknown() {
+		var gc NodepoolKubeletImageGCModel
+		resp.Diagnostics.Append(plan.KubeletImageGC.As(ctx, &gc, basetypes.ObjectAsOptions{
+			UnhandledUnknownAsEmpty: true,
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		request.KubeletImageGC = &exoscale.KubeletImageGC{
+			HighThreshold: gc.HighThreshold.ValueInt64(),
+			LowThreshold:  gc.LowThreshold.ValueInt64(),
+			MinAge:        gc.MinAge.ValueString(),
+		}
+	}
+
+	if len(plan.Labels.Elements()) > 0 {
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &request.Labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request.Taints, diags = nodepoolTaints(ctx, plan.Taints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := client.CreateSKSNodepool(ctx, exoscale.UUID(plan.ClusterID.ValueString()), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	op, err = client.Wait(ctx, op, exoscale.OperationStateSuccess)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to create SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(op.Reference.ID.String())
+
+	// Update computed attributes before saving the state.
+	nodepool, err := client.GetSKSNodepool(ctx, exoscale.UUID(plan.ClusterID.ValueString()), op.Reference.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, nodepool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save plan into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource created", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Read (refresh) resources by receiving Terraform prior state data, performing read logic, and saving refreshed Terraform state data.
+func (r *ResourceNodepool) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceNodepoolModel
+
+	// Load Terraform prior data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Read(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	nodepool, err := client.GetSKSNodepool(
+		ctx,
+		exoscale.UUID(state.ClusterID.ValueString()),
+		exoscale.UUID(state.ID.ValueString()),
+	)
+	if err != nil {
+		if errors.Is(err, exoscale.ErrNotFound) {
+			// Resource (or its parent cluster) doesn't exist anymore, signaling the core to remove it from the state.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"unable to get SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, nodepool, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource read done", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// Update resources in-place by receiving Terraform prior state, configuration, and plan data, performing update logic, and saving updated Terraform state data.
+func (r *ResourceNodepool) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ResourceNodepoolModel
+
+	// Read Terraform prior state data (for comparison) into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := plan.Timeouts.Update(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(plan.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	clusterID := exoscale.UUID(state.ClusterID.ValueString())
+	nodepoolID := exoscale.UUID(state.ID.ValueString())
+
+	// Reset the optional attributes which have been removed, the update request omitting empty values.
+	for _, reset := range []struct {
+		removed bool
+		field   exoscale.ResetSKSNodepoolFieldField
+	}{
+		{
+			removed: len(plan.AntiAffinityGroupIDs.Elements()) == 0 && len(state.AntiAffinityGroupIDs.Elements()) > 0,
+			field:   exoscale.ResetSKSNodepoolFieldFieldAntiAffinityGroups,
+		},
+		{
+			removed: plan.DeployTargetID.ValueString() == "" && state.DeployTargetID.ValueString() != "",
+			field:   exoscale.ResetSKSNodepoolFieldFieldDeployTarget,
+		},
+		{
+			removed: plan.Description.ValueString() == "" && state.Description.ValueString() != "",
+			field:   exoscale.ResetSKSNodepoolFieldFieldDescription,
+		},
+		{
+			removed: len(plan.Labels.Elements()) == 0 && len(state.Labels.Elements()) > 0,
+			field:   exoscale.ResetSKSNodepoolFieldFieldLabels,
+		},
+		{
+			removed: len(plan.PrivateNetworkIDs.Elements()) == 0 && len(state.PrivateNetworkIDs.Elements()) > 0,
+			field:   exoscale.ResetSKSNodepoolFieldFieldPrivateNetworks,
+		},
+		{
+			removed: len(plan.SecurityGroupIDs.Elements()) == 0 && len(state.SecurityGroupIDs.Elements()) > 0,
+			field:   exoscale.ResetSKSNodepoolFieldFieldSecurityGroups,
+		},
+	} {
+		if !reset.removed {
+			continue
+		}
+
+		if err := await(ctx, client)(client.ResetSKSNodepoolField(ctx, clusterID, nodepoolID, reset.field)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("unable to reset SKS nodepool %s", reset.field),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	updated := false
+	request := exoscale.UpdateSKSNodepoolRequest{}
+
+	// The deploy target is always part of the update request, which would reset it otherwise.
+	if v := plan.DeployTargetID.ValueString(); v != "" {
+		request.DeployTarget = &exoscale.DeployTarget{ID: exoscale.UUID(v)}
+		updated = !plan.DeployTargetID.Equal(state.DeployTargetID)
+	}
+
+	ids, diags := nodepoolResourceIDs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AntiAffinityGroupIDs.Equal(state.AntiAffinityGroupIDs) && len(ids.antiAffinityGroups) > 0 {
+		request.AntiAffinityGroups = ids.antiAffinityGroups
+		updated = true
+	}
+
+	if !plan.Description.Equal(state.Description) && plan.Description.ValueString() != "" {
+		request.Description = plan.Description.ValueString()
+		updated = true
+	}
+
+	if !plan.DiskSize.Equal(state.DiskSize) {
+		request.DiskSize = plan.DiskSize.ValueInt64()
+		updated = true
+	}
+
+	if !plan.InstancePrefix.Equal(state.InstancePrefix) {
+		request.InstancePrefix = plan.InstancePrefix.ValueString()
+		updated = true
+	}
+
+	if !sameInstanceTypeName(plan.InstanceType.ValueString(), state.InstanceType.ValueString()) {
+		instanceType, err := utils.FindInstanceTypeByNameV3(ctx, client, plan.InstanceType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"unable to retrieve instance type",
+				err.Error(),
+			)
+			return
+		}
+		request.InstanceType = &exoscale.InstanceType{ID: instanceType.ID}
+		updated = true
+	}
+
+	if !plan.Labels.Equal(state.Labels) && len(plan.Labels.Elements()) > 0 {
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &request.Labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updated = true
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.Name = plan.Name.ValueString()
+		updated = true
+	}
+
+	if !plan.PrivateNetworkIDs.Equal(state.PrivateNetworkIDs) && len(ids.privateNetworks) > 0 {
+		request.PrivateNetworks = ids.privateNetworks
+		updated = true
+	}
+
+	if !plan.SecurityGroupIDs.Equal(state.SecurityGroupIDs) && len(ids.securityGroups) > 0 {
+		request.SecurityGroups = ids.securityGroups
+		updated = true
+	}
+
+	if !plan.Taints.Equal(state.Taints) && len(plan.Taints.Elements()) > 0 {
+		request.Taints, diags = nodepoolTaints(ctx, plan.Taints)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updated = true
+	}
+
+	updateClient := client
+	if len(plan.Taints.Elements()) == 0 && len(state.Taints.Elements()) > 0 {
+		updateClient = withClearedNodepoolTaints(client)
+		updated = true
+	}
+
+	if updated {
+		if err := await(ctx, client)(updateClient.UpdateSKSNodepool(ctx, clusterID, nodepoolID, request)); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update SKS nodepool",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Size.Equal(state.Size) {
+		if err := await(ctx, client)(client.ScaleSKSNodepool(ctx, clusterID, nodepoolID, exoscale.ScaleSKSNodepoolRequest{
+			Size: plan.Size.ValueInt64(),
+		})); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to scale SKS nodepool",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.NodeUpgrade.IsNull() {
+		var upgrade NodepoolNodeUpgradeModel
+		resp.Diagnostics.Append(plan.NodeUpgrade.As(ctx, &upgrade, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The duration has been validated at plan time.
+		pause, _ := time.ParseDuration(upgrade.PauseBetweenBatches.ValueString())
+
+		if err := upgradeNodepoolNodes(
+			ctx,
+			client,
+			clusterID,
+			nodepoolID,
+			plan.Size.ValueInt64(),
+			upgrade.BatchSize.ValueInt64(),
+			pause,
+		); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to upgrade SKS nodepool nodes",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update computed attributes before saving the state.
+	nodepool, err := client.GetSKSNodepool(ctx, clusterID, nodepoolID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to get SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, client, nodepool, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated state into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Trace(ctx, "resource update done", map[string]interface{}{
+		"id": plan.ID,
+	})
+}
+
+// Delete resources by receiving Terraform prior state data and performing deletion logic.
+func (r *ResourceNodepool) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceNodepoolModel
+
+	// Load Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set timeout.
+	timeout, diags := state.Timeouts.Delete(ctx, config.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Use API endpoint in selected zone.
+	client, err := utils.SwitchClientZone(
+		ctx,
+		r.client,
+		exoscale.ZoneName(state.Zone.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to change exoscale client zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := await(ctx, client)(client.DeleteSKSNodepool(
+		ctx,
+		exoscale.UUID(state.ClusterID.ValueString()),
+		exoscale.UUID(state.ID.ValueString()),
+	)); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to delete SKS nodepool",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "resource deleted", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// ImportState lets Terraform begin managing existing infrastructure resources.
+func (r *ResourceNodepool) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "@")
+	var ids []string
+	if len(idParts) == 2 {
+		ids = strings.Split(idParts[0], "/")
+	}
+
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: cluster-id/id@zone. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var state ResourceNodepoolModel
+
+	// Set timeouts (quirk https://github.com/hashicorp/terraform-plugin-framework-timeouts/issues/46)
+	var timeouts timeouts.Value
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = timeouts
+
+	state.ClusterID = types.StringValue(ids[0])
+	state.ID = types.StringValue(ids[1])
+	state.Zone = types.StringValue(idParts[1])
+
+	// Set null values
+	state.AntiAffinityGroupIDs = types.SetNull(types.StringType)
+	state.KubeletImageGC = types.ObjectNull(NodepoolKubeletImageGCModel{}.Types())
+	state.Labels = types.MapNull(types.StringType)
+	state.NodeUpgrade = types.ObjectNull(NodepoolNodeUpgradeModel{}.Types())
+	state.PrivateNetworkIDs = types.SetNull(types.StringType)
+	state.SecurityGroupIDs = types.SetNull(types.StringType)
+	state.Taints = types.MapNull(types.StringType)
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Trace(ctx, "resource imported", map[string]interface{}{
+		"id": state.ID,
+	})
+}
+
+// UpgradeState migrates the state of resources managed by the former SDKv2 implementation.
+func (r *ResourceNodepool) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// SDKv2 to Framework migration requires state upgrade of the kubelet_image_gc
+		// and node_upgrade blocks to remove the set/array from them.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					AttrID:                           schema.StringAttribute{Computed: true},
+					AttrNodepoolAntiAffinityGroupIDs: schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					AttrClusterID:                    schema.StringAttribute{Required: true},
+					AttrCreatedAt:                    schema.StringAttribute{Computed: true},
+					AttrNodepoolDeployTargetID:       schema.StringAttribute{Optional: true},
+					AttrDescription:                  schema.StringAttribute{Optional: true},
+					AttrNodepoolDiskSize:             schema.Int64Attribute{Optional: true},
+					AttrNodepoolInstancePoolID:       schema.StringAttribute{Computed: true},
+					AttrNodepoolInstancePrefix:       schema.StringAttribute{Optional: true},
+					AttrNodepoolInstanceType:         schema.StringAttribute{Required: true},
+					AttrLabels:                       schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					AttrName:                         schema.StringAttribute{Required: true},
+					AttrNodepoolPrivateNetworkIDs:    schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					AttrNodepoolSecurityGroupIDs:     schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					AttrNodepoolSize:                 schema.Int64Attribute{Required: true},
+					AttrState:                        schema.StringAttribute{Computed: true},
+					AttrNodepoolStorageLVM:           schema.BoolAttribute{Optional: true},
+					AttrNodepoolTaints:               schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					AttrNodepoolTemplateID:           schema.StringAttribute{Computed: true},
+					AttrVersion:                      schema.StringAttribute{Computed: true},
+					AttrZone:                         schema.StringAttribute{Required: true},
+				},
+				Blocks: map[string]schema.Block{
+					AttrNodepoolKubeletImageGC: schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								AttrNodepoolKubeletImageGCHighThreshold: schema.Int64Attribute{Optional: true},
+								AttrNodepoolKubeletImageGCLowThreshold:  schema.Int64Attribute{Optional: true},
+								AttrNodepoolKubeletImageGCMinAge:        schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					AttrNodepoolNodeUpgrade: schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								AttrNodepoolNodeUpgradeBatchSize:           schema.Int64Attribute{Optional: true},
+								AttrNodepoolNodeUpgradeClusterVersion:      schema.StringAttribute{Optional: true},
+								AttrNodepoolNodeUpgradePauseBetweenBatches: schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"timeouts": timeouts.BlockAll(ctx),
+				},
+			},
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				priorState := struct {
+					ID                   types.String                  `tfsdk:"id"`
+					AntiAffinityGroupIDs types.Set                     `tfsdk:"anti_affinity_group_ids"`
+					ClusterID            types.String                  `tfsdk:"cluster_id"`
+					CreatedAt            types.String                  `tfsdk:"created_at"`
+					DeployTargetID       types.String                  `tfsdk:"deploy_target_id"`
+					Description          types.String                  `tfsdk:"description"`
+					DiskSize             types.Int64                   `tfsdk:"disk_size"`
+					InstancePoolID       types.String                  `tfsdk:"instance_pool_id"`
+					InstancePrefix       types.String                  `tfsdk:"instance_prefix"`
+					InstanceType         types.String                  `tfsdk:"instance_type"`
+					KubeletImageGC       []NodepoolKubeletImageGCModel `tfsdk:"kubelet_image_gc"`
+					Labels               types.Map                     `tfsdk:"labels"`
+					Name                 types.String                  `tfsdk:"name"`
+					NodeUpgrade          []NodepoolNodeUpgradeModel    `tfsdk:"node_upgrade"`
+					PrivateNetworkIDs    types.Set                     `tfsdk:"private_network_ids"`
+					SecurityGroupIDs     types.Set                     `tfsdk:"security_group_ids"`
+					Size                 types.Int64                   `tfsdk:"size"`
+					State                types.String                  `tfsdk:"state"`
+					StorageLVM           types.Bool                    `tfsdk:"storage_lvm"`
+					Taints               types.Map                     `tfsdk:"taints"`
+					TemplateID           types.String                  `tfsdk:"template_id"`
+					Version              types.String                  `tfsdk:"version"`
+					Zone                 types.String                  `tfsdk:"zone"`
+					Timeouts             timeouts.Value                `tfsdk:"timeouts"`
+				}{}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedStateData := ResourceNodepoolModel{
+					ID:                   priorState.ID,
+					AntiAffinityGroupIDs: nullIfEmptySet(priorState.AntiAffinityGroupIDs),
+					ClusterID:            priorState.ClusterID,
+					CreatedAt:            priorState.CreatedAt,
+					DeployTargetID:       nullIfEmptyString(priorState.DeployTargetID),
+					Description:          nullIfEmptyString(priorState.Description),
+					DiskSize:             priorState.DiskSize,
+					InstancePoolID:       priorState.InstancePoolID,
+					InstancePrefix:       priorState.InstancePrefix,
+					InstanceType:         priorState.InstanceType,
+					KubeletImageGC:       types.ObjectNull(NodepoolKubeletImageGCModel{}.Types()),
+					Labels:               nullIfEmptyMap(priorState.Labels),
+					Name:                 priorState.Name,
+					NodeUpgrade:          types.ObjectNull(NodepoolNodeUpgradeModel{}.Types()),
+					PrivateNetworkIDs:    nullIfEmptySet(priorState.PrivateNetworkIDs),
+					SecurityGroupIDs:     nullIfEmptySet(priorState.SecurityGroupIDs),
+					Size:                 priorState.Size,
+					State:                priorState.State,
+					StorageLVM:           priorState.StorageLVM,
+					Taints:               nullIfEmptyMap(priorState.Taints),
+					TemplateID:           priorState.TemplateID,
+					Version:              priorState.Version,
+					Zone:                 priorState.Zone,
+					Timeouts:             priorState.Timeouts,
+				}
+
+				if upgradedStateData.StorageLVM.IsNull() {
+					upgradedStateData.StorageLVM = types.BoolValue(false)
+				}
+
+				var diags diag.Diagnostics
+				if len(priorState.KubeletImageGC) > 0 {
+					upgradedStateData.KubeletImageGC, diags = types.ObjectValueFrom(
+						ctx,
+						NodepoolKubeletImageGCModel{}.Types(),
+						priorState.KubeletImageGC[0],
+					)
+					resp.Diagnostics.Append(diags...)
+				}
+				if len(priorState.NodeUpgrade) > 0 {
+					upgrade := priorState.NodeUpgrade[0]
+					upgrade.ClusterVersion = nullIfEmptyString(upgrade.ClusterVersion)
+					upgrade.PauseBetweenBatches = nullIfEmptyString(upgrade.PauseBetweenBatches)
+
+					upgradedStateData.NodeUpgrade, diags = types.ObjectValueFrom(
+						ctx,
+						NodepoolNodeUpgradeModel{}.Types(),
+						upgrade,
+					)
+					resp.Diagnostics.Append(diags...)
+				}
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+			},
+		},
+	}
+}
+
+// apply updates the model with the nodepool values from the API.
+func (r *ResourceNodepool) apply(
+	ctx context.Context,
+	client *exoscale.Client,
+	nodepool *exoscale.SKSNodepool,
+	m *ResourceNodepoolModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Keep the configured instance type name unless it designates another type,
+	// since the family of the standard types may be omitted and the case isn't significant.
+	if nodepool.InstanceType != nil {
+		instanceType, err := client.GetInstanceType(ctx, nodepool.InstanceType.ID)
+		if err != nil {
+			diags.AddError(
+				"unable to retrieve instance type",
+				err.Error(),
+			)
+			return diags
+		}
+
+		if name := nodepoolInstanceTypeName(instanceType); !sameInstanceTypeName(name, m.InstanceType.ValueString()) {
+			m.InstanceType = types.StringValue(name)
+		}
+	}
+
+	// Keep unset optional values null rather than empty.
+	antiAffinityGroupIDs := make([]string, len(nodepool.AntiAffinityGroups))
+	for i, v := range nodepool.AntiAffinityGroups {
+		antiAffinityGroupIDs[i] = v.ID.String()
+	}
+	if !m.AntiAffinityGroupIDs.IsNull() || len(antiAffinityGroupIDs) > 0 {
+		m.AntiAffinityGroupIDs, diags = types.SetValueFrom(ctx, types.StringType, antiAffinityGroupIDs)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	privateNetworkIDs := make([]string, len(nodepool.PrivateNetworks))
+	for i, v := range nodepool.PrivateNetworks {
+		privateNetworkIDs[i] = v.ID.String()
+	}
+	if !m.PrivateNetworkIDs.IsNull() || len(privateNetworkIDs) > 0 {
+		m.PrivateNetworkIDs, diags = types.SetValueFrom(ctx, types.StringType, privateNetworkIDs)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	securityGroupIDs := make([]string, len(nodepool.SecurityGroups))
+	for i, v := range nodepool.SecurityGroups {
+		securityGroupIDs[i] = v.ID.String()
+	}
+	if !m.SecurityGroupIDs.IsNull() || len(securityGroupIDs) > 0 {
+		m.SecurityGroupIDs, diags = types.SetValueFrom(ctx, types.StringType, securityGroupIDs)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !m.Labels.IsNull() || len(nodepool.Labels) > 0 {
+		m.Labels, diags = types.MapValueFrom(ctx, types.StringType, map[string]string(nodepool.Labels))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !m.Taints.IsNull() || len(nodepool.Taints) > 0 {
+		taints := make(map[string]string, len(nodepool.Taints))
+		for k, v := range nodepool.Taints {
+			taints[k] = fmt.Sprintf("%s:%s", v.Value, v.Effect)
+		}
+		m.Taints, diags = types.MapValueFrom(ctx, types.StringType, taints)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !m.Description.IsNull() || nodepool.Description != "" {
+		m.Description = types.StringValue(nodepool.Description)
+	}
+
+	deployTargetID := ""
+	if nodepool.DeployTarget != nil {
+		deployTargetID = nodepool.DeployTarget.ID.String()
+	}
+	if !m.DeployTargetID.IsNull() || deployTargetID != "" {
+		m.DeployTargetID = types.StringValue(deployTargetID)
+	}
+
+	m.KubeletImageGC = types.ObjectNull(NodepoolKubeletImageGCModel{}.Types())
+	if gc := nodepool.KubeletImageGC; gc != nil {
+		m.KubeletImageGC, diags = types.ObjectValueFrom(ctx, NodepoolKubeletImageGCModel{}.Types(), NodepoolKubeletImageGCModel{
+			HighThreshold: types.Int64Value(gc.HighThreshold),
+			LowThreshold:  types.Int64Value(gc.LowThreshold),
+			MinAge:        types.StringValue(gc.MinAge),
+		})
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	m.InstancePoolID = types.StringNull()
+	if nodepool.InstancePool != nil {
+		m.InstancePoolID = types.StringValue(nodepool.InstancePool.ID.String())
+	}
+
+	m.TemplateID = types.StringNull()
+	if nodepool.Template != nil {
+		m.TemplateID = types.StringValue(nodepool.Template.ID.String())
+	}
+
+	m.CreatedAt = types.StringValue(nodepool.CreatedAT.String())
+	m.DiskSize = types.Int64Value(nodepool.DiskSize)
+	m.InstancePrefix = types.StringValue(nodepool.InstancePrefix)
+	m.Name = types.StringValue(nodepool.Name)
+	m.Size = types.Int64Value(nodepool.Size)
+	m.State = types.StringValue(string(nodepool.State))
+	m.StorageLVM = types.BoolValue(slices.Contains(nodepool.Addons, nodepoolAddonStorageLVM))
+	m.Version = types.StringValue(nodepool.Version)
+
+	return diags
+}
+
+// nodepoolIDs holds the API references of the resources attached to a nodepool.
+type nodepoolIDs struct {
+	antiAffinityGroups []exoscale.AntiAffinityGroup
+	privateNetworks    []exoscale.PrivateNetwork
+	securityGroups     []exoscale.SecurityGroup
+}
+
+// nodepoolResourceIDs returns the API references of the resources attached to the nodepool of the model.
+func nodepoolResourceIDs(ctx context.Context, m ResourceNodepoolModel) (nodepoolIDs, diag.Diagnostics) {
+	var ids nodepoolIDs
+	var diags diag.Diagnostics
+
+	for _, set := range []struct {
+		value types.Set
+		add   func(id exoscale.UUID)
+	}{
+		{m.AntiAffinityGroupIDs, func(id exoscale.UUID) {
+			ids.antiAffinityGroups = append(ids.antiAffinityGroups, exoscale.AntiAffinityGroup{ID: id})
+		}},
+		{m.PrivateNetworkIDs, func(id exoscale.UUID) {
+			ids.privateNetworks = append(ids.privateNetworks, exoscale.PrivateNetwork{ID: id})
+		}},
+		{m.SecurityGroupIDs, func(id exoscale.UUID) {
+			ids.securityGroups = append(ids.securityGroups, exoscale.SecurityGroup{ID: id})
+		}},
+	} {
+		values, d := stringsFromSet(ctx, set.value)
+		diags.Append(d...)
+		for _, v := range values {
+			set.add(exoscale.UUID(v))
+		}
+	}
+
+	return ids, diags
+}
+
+// nodepoolTaints returns the API taints of the "taints" attribute, or nil if unset.
+func nodepoolTaints(ctx context.Context, v types.Map) (exoscale.SKSNodepoolTaints, diag.Diagnostics) {
+	if len(v.Elements()) == 0 {
+		return nil, nil
+	}
+
+	var values map[string]string
+	diags := v.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	taints := make(exoscale.SKSNodepoolTaints, len(values))
+	for k, value := range values {
+		taint, err := parseNodepoolTaint(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(AttrNodepoolTaints).AtMapKey(k),
+				"invalid taint",
+				fmt.Sprintf("invalid taint %q: %s", value, err),
+			)
+			continue
+		}
+		taints[k] = taint
+	}
+
+	return taints, diags
+}
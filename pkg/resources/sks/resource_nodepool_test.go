@@ -0,0 +1,95 @@
+package sks_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/resources/sks"
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+func testResourceNodepool(t *testing.T) {
+	resourceName := "exoscale_sks_nodepool.test"
+
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.AccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: testutils.ParseTestdataConfig("./testdata/008.nodepool_create.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, sks.AttrClusterID, "exoscale_sks_cluster.test", sks.AttrID),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrName, fmt.Sprintf("terraform-provider-test-%d", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrDescription, "test-description"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrLabels+".test", fmt.Sprintf("test-%d", testdataSpec.ID)),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolTaints+".test", "test:NoSchedule"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolInstanceType, "standard.small"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolInstancePrefix, "pool"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolDiskSize, "20"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolSize, "1"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolStorageLVM, "false"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolAntiAffinityGroupIDs+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolSecurityGroupIDs+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolKubeletImageGC+".high_threshold", "70"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolKubeletImageGC+".low_threshold", "60"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolKubeletImageGC+".min_age", "3m"),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrNodepoolInstancePoolID),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrState),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrNodepoolTemplateID),
+					resource.TestCheckResourceAttrSet(resourceName, sks.AttrVersion),
+				),
+			},
+			{
+				// Update
+				Config: testutils.ParseTestdataConfig("./testdata/009.nodepool_update.tf.tmpl", &testdataSpec),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, sks.AttrName, fmt.Sprintf("terraform-provider-test-%d-updated", testdataSpec.ID)),
+					resource.TestCheckNoResourceAttr(resourceName, sks.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrLabels+".test", fmt.Sprintf("test-%d-updated", testdataSpec.ID)),
+					resource.TestCheckNoResourceAttr(resourceName, sks.AttrNodepoolTaints),
+					resource.TestCheckNoResourceAttr(resourceName, sks.AttrNodepoolSecurityGroupIDs),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolInstanceType, "Standard.Small"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolInstancePrefix, "test"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolSize, "2"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolNodeUpgrade+".batch_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, sks.AttrNodepoolNodeUpgrade+".pause_between_batches", "10s"),
+				),
+			},
+			{
+				// Import
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					clusterID, err := testutils.AttrFromState(s, resourceName, sks.AttrClusterID)
+					if err != nil {
+						return "", err
+					}
+
+					id, err := testutils.AttrFromState(s, resourceName, sks.AttrID)
+					if err != nil {
+						return "", err
+					}
+
+					return fmt.Sprintf("%s/%s@%s", clusterID, id, testdataSpec.Zone), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					sks.AttrNodepoolInstanceType,
+					sks.AttrNodepoolNodeUpgrade,
+					"timeouts",
+				},
+			},
+		},
+	})
+}
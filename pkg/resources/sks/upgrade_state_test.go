@@ -0,0 +1,212 @@
+package sks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testUpgradeState runs the upgrader of the version 0 state of a resource on a raw SDKv2 state.
+func testUpgradeState(t *testing.T, r resource.ResourceWithUpgradeState, raw string) tfsdk.State {
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	require.True(t, ok)
+
+	// The framework ignores the attributes removed from the prior schema.
+	prior, err := tftypes.ValueFromJSONWithOpts(
+		[]byte(raw),
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	)
+	require.NoError(t, err)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return resp.State
+}
+
+func TestResourceClusterUpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("oidc", func(t *testing.T) {
+		state := testUpgradeState(t, &ResourceCluster{}, `{
+			"id": "2d2cfcc3-4c1a-4a5e-8f4b-64b1d1e3f5a1",
+			"addons": ["exoscale-cloud-controller", "metrics-server"],
+			"aggregation_ca": "aggregation-ca",
+			"auto_upgrade": false,
+			"cni": "calico",
+			"control_plane_ca": "control-plane-ca",
+			"created_at": "2024-01-01 00:00:00 +0000 UTC",
+			"description": "",
+			"endpoint": "https://2d2cfcc3-4c1a-4a5e-8f4b-64b1d1e3f5a1.sks-ch-gva-2.exo.io:443",
+			"exoscale_ccm": true,
+			"exoscale_csi": false,
+			"kubelet_ca": "kubelet-ca",
+			"labels": {},
+			"metrics_server": true,
+			"name": "test",
+			"nodepools": [],
+			"oidc": [{
+				"client_id": "client-id",
+				"groups_claim": "",
+				"groups_prefix": "",
+				"issuer_url": "https://id.example.net",
+				"required_claim": {"test": "required-claim"},
+				"username_claim": "username-claim",
+				"username_prefix": ""
+			}],
+			"service_level": "pro",
+			"state": "running",
+			"timeouts": {"create": "10m", "delete": null, "read": null, "update": null},
+			"version": "1.31.1",
+			"zone": "ch-gva-2"
+		}`)
+
+		var m ResourceClusterModel
+		require.False(t, state.Get(ctx, &m).HasError())
+
+		assert.True(t, m.Description.IsNull())
+		assert.True(t, m.Labels.IsNull())
+		assert.Equal(t, types.BoolValue(false), m.AutoUpgrade)
+		assert.Equal(t, types.BoolValue(false), m.FailUpgrade)
+		assert.True(t, m.RotateCCMCreds.IsNull())
+		assert.True(t, m.RotateOperatorsCA.IsNull())
+		assert.Equal(t, "pro", m.ServiceLevel.ValueString())
+		assert.Equal(t, "1.31.1", m.Version.ValueString())
+
+		var oidc ClusterOIDCModel
+		require.False(t, m.OIDC.As(ctx, &oidc, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, "client-id", oidc.ClientID.ValueString())
+		assert.Equal(t, "https://id.example.net", oidc.IssuerURL.ValueString())
+		assert.Equal(t, "username-claim", oidc.UsernameClaim.ValueString())
+		assert.True(t, oidc.GroupsClaim.IsNull())
+		assert.True(t, oidc.GroupsPrefix.IsNull())
+		assert.True(t, oidc.UsernamePrefix.IsNull())
+		assert.Len(t, oidc.RequiredClaim.Elements(), 1)
+
+		var create types.String
+		require.False(t, state.GetAttribute(ctx, path.Root("timeouts").AtName("create"), &create).HasError())
+		assert.Equal(t, "10m", create.ValueString())
+	})
+
+	t.Run("no oidc", func(t *testing.T) {
+		state := testUpgradeState(t, &ResourceCluster{}, `{
+			"id": "2d2cfcc3-4c1a-4a5e-8f4b-64b1d1e3f5a1",
+			"description": "test-description",
+			"labels": {"test": "test"},
+			"name": "test",
+			"oidc": [],
+			"rotate_operators_ca_trigger": "1",
+			"zone": "ch-gva-2"
+		}`)
+
+		var m ResourceClusterModel
+		require.False(t, state.Get(ctx, &m).HasError())
+
+		assert.True(t, m.OIDC.IsNull())
+		assert.Equal(t, "test-description", m.Description.ValueString())
+		assert.Len(t, m.Labels.Elements(), 1)
+		assert.Equal(t, "1", m.RotateOperatorsCA.ValueString())
+	})
+}
+
+func TestResourceNodepoolUpgradeState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nested blocks", func(t *testing.T) {
+		state := testUpgradeState(t, &ResourceNodepool{}, `{
+			"id": "6a0d8d5e-1b4f-4c2a-9e3d-7f8a9b0c1d2e",
+			"anti_affinity_group_ids": [],
+			"cluster_id": "2d2cfcc3-4c1a-4a5e-8f4b-64b1d1e3f5a1",
+			"created_at": "2024-01-01 00:00:00 +0000 UTC",
+			"deploy_target_id": "",
+			"description": "",
+			"disk_size": 50,
+			"instance_pool_id": "3b4c5d6e-7f80-4192-a3b4-c5d6e7f80912",
+			"instance_prefix": "pool",
+			"instance_type": "standard.small",
+			"kubelet_image_gc": [{"high_threshold": 70, "low_threshold": 60, "min_age": "3m"}],
+			"labels": {},
+			"name": "test",
+			"node_upgrade": [{"batch_size": 2, "cluster_version": "", "pause_between_batches": "30s"}],
+			"private_network_ids": [],
+			"security_group_ids": ["4c5d6e7f-8091-4a2b-b3c4-d5e6f7081920"],
+			"size": 3,
+			"state": "running",
+			"storage_lvm": false,
+			"taints": {"test": "test:NoSchedule"},
+			"template_id": "5d6e7f80-9102-4b3c-84d5-e6f708192031",
+			"timeouts": null,
+			"version": "1.31.1",
+			"zone": "ch-gva-2"
+		}`)
+
+		var m ResourceNodepoolModel
+		require.False(t, state.Get(ctx, &m).HasError())
+
+		assert.True(t, m.AntiAffinityGroupIDs.IsNull())
+		assert.True(t, m.DeployTargetID.IsNull())
+		assert.True(t, m.Description.IsNull())
+		assert.True(t, m.Labels.IsNull())
+		assert.True(t, m.PrivateNetworkIDs.IsNull())
+		assert.Len(t, m.SecurityGroupIDs.Elements(), 1)
+		assert.Len(t, m.Taints.Elements(), 1)
+		assert.Equal(t, int64(3), m.Size.ValueInt64())
+
+		var gc NodepoolKubeletImageGCModel
+		require.False(t, m.KubeletImageGC.As(ctx, &gc, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, int64(70), gc.HighThreshold.ValueInt64())
+		assert.Equal(t, int64(60), gc.LowThreshold.ValueInt64())
+		assert.Equal(t, "3m", gc.MinAge.ValueString())
+
+		var upgrade NodepoolNodeUpgradeModel
+		require.False(t, m.NodeUpgrade.As(ctx, &upgrade, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, int64(2), upgrade.BatchSize.ValueInt64())
+		assert.True(t, upgrade.ClusterVersion.IsNull())
+		assert.Equal(t, "30s", upgrade.PauseBetweenBatches.ValueString())
+	})
+
+	t.Run("no nested blocks", func(t *testing.T) {
+		state := testUpgradeState(t, &ResourceNodepool{}, `{
+			"id": "6a0d8d5e-1b4f-4c2a-9e3d-7f8a9b0c1d2e",
+			"cluster_id": "2d2cfcc3-4c1a-4a5e-8f4b-64b1d1e3f5a1",
+			"instance_type": "standard.small",
+			"kubelet_image_gc": [],
+			"name": "test",
+			"size": 1,
+			"taints": {},
+			"zone": "ch-gva-2"
+		}`)
+
+		var m ResourceNodepoolModel
+		require.False(t, state.Get(ctx, &m).HasError())
+
+		assert.True(t, m.KubeletImageGC.IsNull())
+		assert.True(t, m.NodeUpgrade.IsNull())
+		assert.True(t, m.Taints.IsNull())
+		assert.Equal(t, types.BoolValue(false), m.StorageLVM)
+	})
+}
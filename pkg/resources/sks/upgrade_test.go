@@ -0,0 +1,47 @@
+package sks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/exoscale/terraform-provider-exoscale/pkg/testutils"
+)
+
+// testSDKProviderVersion is the last provider release implementing the SKS resources with the SDKv2.
+const testSDKProviderVersion = "0.62.3"
+
+func testResourceUpgradeFromSDK(t *testing.T) {
+	testdataSpec := testutils.TestdataSpec{
+		ID:   time.Now().UnixNano(),
+		Zone: testutils.TestZoneName,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testutils.AccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create resources with the SDKv2 implementation
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"exoscale": {
+						Source:            "exoscale/exoscale",
+						VersionConstraint: testSDKProviderVersion,
+					},
+				},
+				Config: testutils.ParseTestdataConfig("./testdata/013.upgrade_sdk.tf.tmpl", &testdataSpec),
+			},
+			{
+				// The upgraded state must match the same configuration with the framework implementation
+				ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+				Config:                   testutils.ParseTestdataConfig("./testdata/014.upgrade_framework.tf.tmpl", &testdataSpec),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
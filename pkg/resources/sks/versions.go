@@ -0,0 +1,96 @@
+package sks
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseMinorVersion returns the major and minor numbers of a Kubernetes version
+// such as "1.30", "1.30.2" or "v1.30.2".
+func parseMinorVersion(v string) ([2]int, bool) {
+	var ret [2]int
+
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".", 3)
+	if len(parts) < 2 {
+		return ret, false
+	}
+
+	for i := range ret {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return ret, false
+		}
+		ret[i] = n
+	}
+
+	return ret, true
+}
+
+// parseVersion returns the numeric components of a Kubernetes version such as "1.30.2" or "v1.30.2".
+func parseVersion(v string) ([]int, bool) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".")
+
+	ret := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		ret[i] = n
+	}
+
+	return ret, true
+}
+
+// compareVersions compares two Kubernetes versions numerically, returning -1, 0 or 1.
+// Versions which can't be parsed are sorted before the valid ones, lexicographically.
+func compareVersions(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(va) < len(vb):
+		return -1
+	case len(va) > len(vb):
+		return 1
+	}
+
+	return 0
+}
+
+// selectVersions returns the given versions sorted from the most recent to the oldest,
+// only keeping those of the given minor release (e.g. "1.30") if set.
+func selectVersions(versions []string, minor string) []string {
+	ret := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if minor != "" && v != minor && !strings.HasPrefix(strings.TrimPrefix(v, "v"), strings.TrimPrefix(minor, "v")+".") {
+			continue
+		}
+		ret = append(ret, v)
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return compareVersions(ret[i], ret[j]) > 0
+	})
+
+	return ret
+}
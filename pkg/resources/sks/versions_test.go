@@ -0,0 +1,26 @@
+package sks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectVersions(t *testing.T) {
+	versions := []string{"1.29.10", "1.30.2", "1.30.10", "1.31.0", "1.3.1"}
+
+	assert.Equal(t,
+		[]string{"1.31.0", "1.30.10", "1.30.2", "1.29.10", "1.3.1"},
+		selectVersions(versions, ""),
+	)
+	assert.Equal(t, []string{"1.30.10", "1.30.2"}, selectVersions(versions, "1.30"))
+	assert.Equal(t, []string{"1.3.1"}, selectVersions(versions, "1.3"))
+	assert.Empty(t, selectVersions(versions, "1.28"))
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("1.30.2", "v1.30.2"))
+	assert.Equal(t, -1, compareVersions("1.30", "1.30.0"))
+	assert.Equal(t, 1, compareVersions("1.30.10", "1.30.9"))
+	assert.Equal(t, -1, compareVersions("latest", "1.0.0"))
+}
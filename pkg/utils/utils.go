@@ -139,6 +139,19 @@ func SuppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
 }
 
+// SuppressUserDataDiff doesn't show differences between user data which decode to the same content,
+// e.g. a base64-encoded (and possibly gzip-compressed) configuration and the decoded state.
+func SuppressUserDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	decode := func(v string) string {
+		if decoded, err := DecodeUserData(v); err == nil {
+			return decoded
+		}
+		return v
+	}
+
+	return decode(old) == decode(new)
+}
+
 // EncodeUserData does compression and base64 encoding, used in resource_exoscale_compute[_instance[_pool]]
 // returns (user_data, user_data_already_base64, error)
 func EncodeUserData(userData string) (string, bool, error) {
@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsDurationValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:    "empty",
+			value:   types.StringValue(""),
+			wantErr: true,
+		},
+		{
+			name:    "missing unit",
+			value:   types.StringValue("5"),
+			wantErr: true,
+		},
+		{
+			name:  "seconds",
+			value: types.StringValue("30s"),
+		},
+		{
+			name:  "hours and minutes",
+			value: types.StringValue("1h30m"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("pause"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			IsDurationValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("IsDurationValidator.ValidateString() error = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}